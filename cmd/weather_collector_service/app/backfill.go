@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/weather-collector-service/internal/metrics"
+	"github.com/meteogo/weather-collector-service/internal/services/backfill_service"
+	"github.com/meteogo/weather-collector-service/internal/services/city_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+const BackfillCommand = "backfill"
+
+func RunBackfill(ctx context.Context, provider config.Provider, args []string) error {
+	var (
+		flags     = flag.NewFlagSet(BackfillCommand, flag.ContinueOnError)
+		cityName  = flags.String("city", "", "name of a city from the cities table")
+		country   = flags.String("country", "", "country of the city, needed when several countries have a city of that name")
+		from      = flags.String("from", "", "first day to backfill, YYYY-MM-DD")
+		to        = flags.String("to", time.Now().UTC().AddDate(0, 0, -1).Format(time.DateOnly), "last day to backfill, YYYY-MM-DD")
+		chunkDays = flags.Int("chunk-days", 30, "number of days fetched and stored per step")
+		publish   = flags.Bool("publish", false, "re-publish backfilled conditions to kafka")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	fromDate, err := time.Parse(time.DateOnly, *from)
+	if err != nil {
+		return fmt.Errorf("invalid -from value: %w", err)
+	}
+
+	toDate, err := time.Parse(time.DateOnly, *to)
+	if err != nil {
+		return fmt.Errorf("invalid -to value: %w", err)
+	}
+
+	backfillConfig, err := backfill_service.NewConfig(provider)
+	if err != nil {
+		return err
+	}
+
+	var (
+		repositories = InitRepositories(ctx, provider)
+		clients      = InitClients(provider)
+		publisher    backfill_service.Publisher
+	)
+
+	// Disabled cities can be backfilled too, their history stays readable.
+	cities, err := repositories.WeatherRepo.ListCities(ctx)
+	if err != nil {
+		return err
+	}
+
+	city, err := findCity(cities, *cityName, *country)
+	if err != nil {
+		return err
+	}
+
+	if *publish {
+		// A backfill run is short-lived and does not serve metrics.
+		publisher = InitPublishers(ctx, provider, Metrics{manager: metrics.NewManager()}).weather
+	}
+
+	service := backfill_service.NewService(backfillConfig, clients.openMeteoClient, publisher, repositories.WeatherRepo)
+	return service.Backfill(ctx, backfill_service.Request{
+		City:      city,
+		From:      fromDate,
+		To:        toDate,
+		ChunkDays: *chunkDays,
+		Publish:   *publish,
+	})
+}
+
+func findCity(cities city_service.Cities, name, country string) (weather_service.City, error) {
+	if name == "" {
+		return weather_service.City{}, errors.New("-city flag is required")
+	}
+
+	var found []city_service.City
+	for _, city := range cities {
+		if city.Name == name && (country == "" || city.Country == country) {
+			found = append(found, city)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return weather_service.City{}, fmt.Errorf("city %q is not present in the cities table", name)
+	case 1:
+		return weather_service.City{ID: found[0].ID, Name: found[0].Name, Coordinates: found[0].Coordinates}, nil
+	default:
+		return weather_service.City{}, fmt.Errorf("several countries have a city %q, pass -country", name)
+	}
+}
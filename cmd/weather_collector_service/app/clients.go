@@ -1,15 +1,49 @@
 package app
 
-import "github.com/meteogo/weather-collector-service/internal/clients/open_meteo"
+import (
+	"net/http"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/weather-collector-service/internal/clients/met_norway"
+	"github.com/meteogo/weather-collector-service/internal/clients/open_meteo"
+	"github.com/meteogo/weather-collector-service/internal/clients/registry"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+)
 
 type Clients struct {
 	openMeteoClient *open_meteo.Client
+	providers       *registry.Registry
 }
 
-func InitClients() Clients {
-	urlGenerator := open_meteo.NewURLGenerator()
+func InitClients(provider config.Provider) Clients {
+	configClient := provider.GetConfigClient()
+
+	units, err := enums.ParseUnitSystem(configClient.GetValue(appconfig.OpenMeteoUnitSystem).String())
+	if err != nil {
+		panic(err)
+	}
+
+	var (
+		urlGenerator        = open_meteo.NewURLGenerator(units)
+		openMeteoHTTPClient = &http.Client{Timeout: configClient.GetValue(appconfig.OpenMeteoRequestTimeout).Duration()}
+		openMeteoRetry      = open_meteo.RetryPolicy{
+			MaxRetries: configClient.GetValue(appconfig.OpenMeteoMaxRetries).Int(),
+			BaseDelay:  configClient.GetValue(appconfig.OpenMeteoRetryBaseDelay).Duration(),
+			MaxDelay:   configClient.GetValue(appconfig.OpenMeteoRetryMaxDelay).Duration(),
+		}
+		openMeteoClient     = open_meteo.NewOpenMeteoClient(urlGenerator, openMeteoHTTPClient, openMeteoRetry)
+		metNorwayUserAgent  = configClient.GetValue(appconfig.MetNorwayUserAgent).String()
+		metNorwayHTTPClient = &http.Client{Timeout: configClient.GetValue(appconfig.MetNorwayRequestTimeout).Duration()}
+		metNorwayClient     = met_norway.NewMetNorwayClient(met_norway.DefaultBaseURL, metNorwayUserAgent, metNorwayHTTPClient)
+	)
+
+	providers := registry.NewRegistry()
+	providers.Register(enums.ProviderOpenMeteo, openMeteoClient)
+	providers.Register(enums.ProviderMetNorway, metNorwayClient)
 
 	return Clients{
-		openMeteoClient: open_meteo.NewOpenMeteoClient(urlGenerator),
+		openMeteoClient: openMeteoClient,
+		providers:       providers,
 	}
 }
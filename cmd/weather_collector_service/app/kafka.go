@@ -0,0 +1,121 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/kafka/connection"
+	"github.com/meteogo/weather-collector-service/internal/kafka/topics"
+)
+
+// kafkaConnection reads the broker list and security settings. TLS files and
+// SASL credentials are only read from secrets when the feature is enabled.
+func kafkaConnection(provider config.Provider) connection.Config {
+	brokers, err := connection.ParseBrokers(provider.GetSecretClient().GetSecret(appconfig.KafkaBrokers).String())
+	if err != nil {
+		panic(err)
+	}
+
+	mechanism, err := connection.ParseSASLMechanism(provider.GetConfigClient().GetValue(appconfig.KafkaSASLMechanism).String())
+	if err != nil {
+		panic(err)
+	}
+
+	cfg := connection.Config{
+		Brokers: brokers,
+		TLS: connection.TLS{
+			Enabled: provider.GetConfigClient().GetValue(appconfig.KafkaTLSEnabled).Bool(),
+		},
+		SASL: connection.SASL{
+			Mechanism: mechanism,
+		},
+	}
+
+	if cfg.TLS.Enabled {
+		cfg.TLS.CAFile = provider.GetSecretClient().GetSecret(appconfig.KafkaTLSCAFile).String()
+		cfg.TLS.CertFile = provider.GetSecretClient().GetSecret(appconfig.KafkaTLSCertFile).String()
+		cfg.TLS.KeyFile = provider.GetSecretClient().GetSecret(appconfig.KafkaTLSKeyFile).String()
+	}
+
+	if cfg.SASL.Mechanism != connection.SASLMechanismNone {
+		cfg.SASL.Username = provider.GetSecretClient().GetSecret(appconfig.KafkaSASLUsername).String()
+		cfg.SASL.Password = provider.GetSecretClient().GetSecret(appconfig.KafkaSASLPassword).String()
+	}
+
+	return cfg
+}
+
+func kafkaWriterTuning(provider config.Provider) connection.Writer {
+	compression, err := connection.ParseCompression(provider.GetConfigClient().GetValue(appconfig.KafkaCompression).String())
+	if err != nil {
+		panic(err)
+	}
+
+	acks, err := connection.ParseRequiredAcks(provider.GetConfigClient().GetValue(appconfig.KafkaRequiredAcks).String())
+	if err != nil {
+		panic(err)
+	}
+
+	return connection.Writer{
+		Compression:  compression,
+		BatchSize:    provider.GetConfigClient().GetValue(appconfig.KafkaBatchSize).Int(),
+		BatchTimeout: provider.GetConfigClient().GetValue(appconfig.KafkaBatchTimeout).Duration(),
+		RequiredAcks: acks,
+		MaxAttempts:  provider.GetConfigClient().GetValue(appconfig.KafkaMaxAttempts).Int(),
+	}
+}
+
+// kafkaAdminTimeout bounds the metadata and admin requests of the topic
+// manager.
+const kafkaAdminTimeout = 10 * time.Second
+
+// ensureKafkaTopics provisions and checks the topics without failing the
+// start, an unreachable cluster or a topic differing from its spec shows up
+// in the readiness of the manager.
+func ensureKafkaTopics(ctx context.Context, provider config.Provider, cfg connection.Config, weatherTopic, deadLetterTopic, alertsTopic string) *topics.Manager {
+	client, err := cfg.NewClient(kafkaAdminTimeout)
+	if err != nil {
+		panic(err)
+	}
+
+	var (
+		replicationFactor = provider.GetConfigClient().GetValue(appconfig.KafkaWeatherTopicReplicationFactor).Int()
+		manager           = topics.NewManager(
+			client,
+			provider.GetConfigClient().GetValue(appconfig.KafkaTopicAutoCreate).Bool(),
+			topics.Spec{
+				Name:              weatherTopic,
+				Partitions:        provider.GetConfigClient().GetValue(appconfig.KafkaWeatherTopicPartitions).Int(),
+				ReplicationFactor: replicationFactor,
+				CleanupPolicy:     provider.GetConfigClient().GetValue(appconfig.KafkaWeatherTopicCleanupPolicy).String(),
+			},
+			topics.Spec{
+				Name:              deadLetterTopic,
+				Partitions:        1,
+				ReplicationFactor: replicationFactor,
+				CleanupPolicy:     "delete",
+			},
+			topics.Spec{
+				Name:              alertsTopic,
+				Partitions:        1,
+				ReplicationFactor: replicationFactor,
+				CleanupPolicy:     "delete",
+			},
+		)
+	)
+
+	ensureCtx, cancel := context.WithTimeout(ctx, kafkaAdminTimeout)
+	defer cancel()
+
+	if err := manager.Ensure(ensureCtx); err != nil {
+		logger.Error(ctx, "kafka topics are not as configured", slog.Any("error", err))
+		return manager
+	}
+
+	logger.Info(ctx, "kafka topics checked successfully")
+	return manager
+}
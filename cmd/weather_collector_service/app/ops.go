@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/closer"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/health"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+type Ops struct {
+	collected *health.Heartbeat
+	sent      *health.Heartbeat
+}
+
+// InitOps starts the /livez and /readyz server. Liveness has no checks of
+// its own, a restart does not fix an unreachable database or broker. Those,
+// as well as stale collection and sending, are reported by readiness.
+func InitOps(ctx context.Context, provider config.Provider, repositories Repositories, publishers Publishers) Ops {
+	ops := Ops{
+		collected: health.NewHeartbeat(provider.GetConfigClient().GetValue(appconfig.OpsCollectDataMaxAge).Duration()),
+		sent:      health.NewHeartbeat(provider.GetConfigClient().GetValue(appconfig.OpsSendDataMaxAge).Duration()),
+	}
+
+	server := health.NewServer(
+		provider.GetConfigClient().GetValue(appconfig.OpsHTTPAddress).String(),
+		provider.GetConfigClient().GetValue(appconfig.OpsCheckTimeout).Duration(),
+		health.Checks{},
+		health.Checks{
+			"postgres":     health.CheckerFunc(repositories.WeatherRepo.Ping),
+			"kafka":        health.CheckerFunc(publishers.Ready),
+			"collect_data": ops.collected,
+			"send_data":    ops.sent,
+		},
+	)
+	server.Start(ctx)
+
+	closer.Add(func(ctx context.Context) error {
+		logger.Info(ctx, "stopping ops server")
+		return server.Stop(ctx)
+	})
+
+	return ops
+}
+
+// trackedWeatherService records the successful collection and sending runs
+// for the readiness checks.
+type trackedWeatherService struct {
+	*weather_service.Service
+	ops Ops
+}
+
+func (s trackedWeatherService) CollectData(ctx context.Context) error {
+	if err := s.Service.CollectData(ctx); err != nil {
+		return err
+	}
+
+	s.ops.collected.Beat()
+	return nil
+}
+
+func (s trackedWeatherService) SendData(ctx context.Context) error {
+	if err := s.Service.SendData(ctx); err != nil {
+		return err
+	}
+
+	s.ops.sent.Beat()
+	return nil
+}
@@ -2,50 +2,96 @@ package app
 
 import (
 	"context"
-	"net"
 
 	"github.com/meteogo/config/pkg/config"
 	"github.com/meteogo/logger/pkg/logger"
 	"github.com/meteogo/weather-collector-service/internal/closer"
 	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/kafka/encoder"
 	weather_publisher "github.com/meteogo/weather-collector-service/internal/kafka/publisher/weather"
+	"github.com/meteogo/weather-collector-service/internal/kafka/topics"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
 	"github.com/segmentio/kafka-go"
 )
 
 type Publishers struct {
 	weather *weather_publisher.WeatherPublisher
+	topics  *topics.Manager
 }
 
-func InitPublishers(ctx context.Context, provider config.Provider) Publishers {
+func InitPublishers(ctx context.Context, provider config.Provider, metrics Metrics) Publishers {
+	weatherUnits, err := enums.ParseUnitSystem(provider.GetConfigClient().GetValue(appconfig.KafkaWeatherUnitSystem).String())
+	if err != nil {
+		panic(err)
+	}
+
+	weatherEncoder, err := encoder.New(encoder.Format(provider.GetConfigClient().GetValue(appconfig.KafkaWeatherEncoding).String()))
+	if err != nil {
+		panic(err)
+	}
+
+	weatherMode, err := weather_publisher.ParseMode(provider.GetConfigClient().GetValue(appconfig.KafkaWeatherMode).String())
+	if err != nil {
+		panic(err)
+	}
+
+	// Per-city messages are keyed by the city, hashing the key keeps every
+	// city on one partition.
+	var weatherBalancer kafka.Balancer = &kafka.LeastBytes{}
+	if weatherMode == weather_publisher.ModePerCity {
+		weatherBalancer = &kafka.Hash{}
+	}
+
 	var (
-		host          = provider.GetSecretClient().GetSecret(appconfig.KafkaHost).String()
-		port          = provider.GetSecretClient().GetSecret(appconfig.KafkaPort).String()
-		weatherTopic  = provider.GetSecretClient().GetSecret(appconfig.KafkaWeatherTopicName).String()
-		weatherWriter = &kafka.Writer{
-			Addr:     kafka.TCP(net.JoinHostPort(host, port)),
-			Topic:    weatherTopic,
-			Balancer: &kafka.LeastBytes{},
-		}
-		weatherPublisher = weather_publisher.NewPublisher(weatherWriter)
+		kafkaConfig     = kafkaConnection(provider)
+		tuning          = kafkaWriterTuning(provider)
+		weatherTopic    = provider.GetSecretClient().GetSecret(appconfig.KafkaWeatherTopicName).String()
+		deadLetterTopic = provider.GetSecretClient().GetSecret(appconfig.KafkaWeatherDeadLetterTopicName).String()
+		alertsTopic     = provider.GetSecretClient().GetSecret(appconfig.KafkaWeatherAlertsTopicName).String()
+	)
+
+	weatherWriter, err := kafkaConfig.NewWriter(weatherTopic, weatherBalancer, tuning)
+	if err != nil {
+		panic(err)
+	}
+
+	deadLetterWriter, err := kafkaConfig.NewWriter(deadLetterTopic, &kafka.Hash{}, tuning)
+	if err != nil {
+		panic(err)
+	}
+
+	alertWriter, err := kafkaConfig.NewWriter(alertsTopic, &kafka.Hash{}, tuning)
+	if err != nil {
+		panic(err)
+	}
+
+	weatherPublisher := weather_publisher.NewPublisher(
+		weatherWriter,
+		deadLetterWriter,
+		alertWriter,
+		weatherEncoder,
+		weatherUnits,
+		weatherMode,
+		weather_publisher.Source{
+			Application: provider.GetConfigClient().GetValue(appconfig.ApplicationName).String(),
+			Env:         provider.GetConfigClient().GetValue(appconfig.Env).String(),
+		},
+		metrics.manager,
 	)
 
-	mustConnectToKafka(ctx, host, port, weatherTopic)
+	topicManager := ensureKafkaTopics(ctx, provider, kafkaConfig, weatherTopic, deadLetterTopic, alertsTopic)
 	closer.Add(func(ctx context.Context) error {
 		logger.Info(ctx, "closing weather publisher")
 		return weatherPublisher.Close(ctx)
 	})
 	return Publishers{
 		weather: weatherPublisher,
+		topics:  topicManager,
 	}
 }
 
-func mustConnectToKafka(ctx context.Context, host, port, topic string) {
-	conn, err := kafka.DialLeader(ctx, "tcp", net.JoinHostPort(host, port), topic, 0)
-	if err != nil {
-		logger.Error(ctx, "can not establish connection with kafka")
-		panic(err)
-	}
-
-	logger.Info(ctx, "connection to kafka established successfully")
-	defer conn.Close()
+// Ready reports whether the kafka cluster is reachable and the topics exist
+// as configured.
+func (p Publishers) Ready(ctx context.Context) error {
+	return p.topics.Ready(ctx)
 }
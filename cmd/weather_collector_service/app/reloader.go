@@ -0,0 +1,32 @@
+package app
+
+import (
+	"context"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/closer"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/reloader"
+)
+
+// InitReloader starts watching the config file. It has to run after the
+// other Init functions, they register the configs it reloads.
+func InitReloader(ctx context.Context, provider config.Provider, path string, metrics Metrics) *reloader.Watcher {
+	watcher := reloader.NewWatcher(
+		path,
+		provider.GetConfigClient().GetValue(appconfig.ConfigReloadInterval).Duration(),
+		metrics.manager,
+	)
+
+	if err := watcher.Start(ctx); err != nil {
+		panic(err)
+	}
+
+	closer.Add(func(ctx context.Context) error {
+		logger.Info(ctx, "stopping config watcher")
+		return watcher.Stop(ctx)
+	})
+
+	return watcher
+}
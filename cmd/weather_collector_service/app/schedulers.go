@@ -6,8 +6,13 @@ import (
 	"github.com/meteogo/config/pkg/config"
 	"github.com/meteogo/logger/pkg/logger"
 	"github.com/meteogo/weather-collector-service/internal/closer"
+	"github.com/meteogo/weather-collector-service/internal/reloader"
+	"github.com/meteogo/weather-collector-service/internal/schedulers/city_refresh_cron"
+	"github.com/meteogo/weather-collector-service/internal/schedulers/periodic"
 	"github.com/meteogo/weather-collector-service/internal/schedulers/weather_collector_cron"
+	"github.com/meteogo/weather-collector-service/internal/schedulers/weather_forecast_cron"
 	"github.com/meteogo/weather-collector-service/internal/schedulers/weather_sender_cron"
+	"github.com/meteogo/weather-collector-service/internal/schedulers/weather_snapshot_cron"
 	"github.com/robfig/cron/v3"
 )
 
@@ -15,24 +20,60 @@ type Schedulers struct {
 	WeatherCollectorCron *weather_collector_cron.Cron
 }
 
-func InitSchedulers(ctx context.Context, provider config.Provider, services Services) Schedulers {
+func InitSchedulers(ctx context.Context, provider config.Provider, services Services, ops Ops) Schedulers {
 	c := cron.New()
+	tracked := trackedWeatherService{Service: services.WeatherService, ops: ops}
+
 	weatherCollectorConfig, err := weather_collector_cron.NewConfig(provider)
 	if err != nil {
 		panic(err)
 	}
 
-	weatherCollectorCron := weather_collector_cron.NewCron(weatherCollectorConfig, c, services.WeatherService)
+	weatherCollectorCron := weather_collector_cron.NewCron(weatherCollectorConfig, c, tracked)
 	weatherCollectorCron.Start(ctx)
 
+	addCronReloader("weather collector cron", weatherCollectorConfig, weatherCollectorCron)
+
 	weatherSenderConfig, err := weather_sender_cron.NewConfig(provider)
 	if err != nil {
 		panic(err)
 	}
 
-	weatherSenderCron := weather_sender_cron.NewCron(weatherSenderConfig, c, services.WeatherService)
+	weatherSenderCron := weather_sender_cron.NewCron(weatherSenderConfig, c, tracked)
 	weatherSenderCron.Start(ctx)
 
+	addCronReloader("weather sender cron", weatherSenderConfig, weatherSenderCron)
+
+	weatherForecastConfig, err := weather_forecast_cron.NewConfig(provider)
+	if err != nil {
+		panic(err)
+	}
+
+	weatherForecastCron := weather_forecast_cron.NewCron(weatherForecastConfig, c, services.WeatherService)
+	weatherForecastCron.Start(ctx)
+
+	addCronReloader("weather forecast cron", weatherForecastConfig, weatherForecastCron)
+
+	weatherSnapshotConfig, err := weather_snapshot_cron.NewConfig(provider)
+	if err != nil {
+		panic(err)
+	}
+
+	weatherSnapshotCron := weather_snapshot_cron.NewCron(weatherSnapshotConfig, c, services.WeatherService)
+	weatherSnapshotCron.Start(ctx)
+
+	addCronReloader("weather snapshot cron", weatherSnapshotConfig, weatherSnapshotCron)
+
+	cityRefreshConfig, err := city_refresh_cron.NewConfig(provider)
+	if err != nil {
+		panic(err)
+	}
+
+	cityRefreshCron := city_refresh_cron.NewCron(cityRefreshConfig, c, services.CityService)
+	cityRefreshCron.Start(ctx)
+
+	addCronReloader("city refresh cron", cityRefreshConfig, cityRefreshCron)
+
 	closer.Add(func(ctx context.Context) error {
 		logger.Info(ctx, "stopping weather collector cron")
 		weatherCollectorCron.Stop(ctx)
@@ -45,7 +86,41 @@ func InitSchedulers(ctx context.Context, provider config.Provider, services Serv
 		return nil
 	})
 
+	closer.Add(func(ctx context.Context) error {
+		logger.Info(ctx, "stopping weather forecast cron")
+		weatherForecastCron.Stop(ctx)
+		return nil
+	})
+
+	closer.Add(func(ctx context.Context) error {
+		logger.Info(ctx, "stopping weather snapshot cron")
+		weatherSnapshotCron.Stop(ctx)
+		return nil
+	})
+
+	closer.Add(func(ctx context.Context) error {
+		logger.Info(ctx, "stopping city refresh cron")
+		cityRefreshCron.Stop(ctx)
+		return nil
+	})
+
 	return Schedulers{
 		WeatherCollectorCron: weatherCollectorCron,
 	}
 }
+
+// addCronReloader registers the duration of a cron for reload, the cron is
+// moved once the new duration was applied.
+func addCronReloader(name string, duration *periodic.Interval, cron interface{ Reschedule(ctx context.Context) }) {
+	reloader.Add(name, func(_ context.Context, provider config.Provider) (func(context.Context), error) {
+		apply, err := duration.Prepare(provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(ctx context.Context) {
+			apply()
+			cron.Reschedule(ctx)
+		}, nil
+	})
+}
@@ -1,33 +1,90 @@
 package app
 
 import (
+	"context"
+
 	"github.com/meteogo/config/pkg/config"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/reloader"
+	"github.com/meteogo/weather-collector-service/internal/services/alert_service"
+	"github.com/meteogo/weather-collector-service/internal/services/city_service"
+	"github.com/meteogo/weather-collector-service/internal/services/subscription_service"
 	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
 )
 
 type Services struct {
 	WeatherService *weather_service.Service
+	AlertService   *alert_service.Service
+	Subscriptions  *subscription_service.Service
+	CityService    *city_service.Service
 }
 
 func InitServices(
+	ctx context.Context,
 	provider config.Provider,
 	clients Clients,
 	publishers Publishers,
 	repositories Repositories,
 	metrics Metrics,
 ) Services {
-	weatherServiceConfig, err := weather_service.NewConfig(provider)
+	cityServiceConfig, err := city_service.NewConfig(provider)
+	if err != nil {
+		panic(err)
+	}
+
+	cityService := city_service.NewService(cityServiceConfig, repositories.WeatherRepo)
+	if err := cityService.Seed(ctx); err != nil {
+		panic(err)
+	}
+
+	if err := cityService.Refresh(ctx); err != nil {
+		panic(err)
+	}
+
+	weatherServiceConfig, err := weather_service.NewConfig(provider, cityService, clients.providers)
+	if err != nil {
+		panic(err)
+	}
+
+	alertServiceConfig, err := alert_service.NewConfig(provider)
 	if err != nil {
 		panic(err)
 	}
 
+	reloader.Add("weather service", func(_ context.Context, provider config.Provider) (func(context.Context), error) {
+		apply, err := weatherServiceConfig.Prepare(provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(context.Context) { apply() }, nil
+	})
+
+	reloader.Add("alert rules", func(_ context.Context, provider config.Provider) (func(context.Context), error) {
+		apply, err := alertServiceConfig.Prepare(provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(context.Context) { apply() }, nil
+	})
+
+	alertService := alert_service.NewService(alertServiceConfig, publishers.weather, repositories.WeatherRepo)
+	subscriptions := subscription_service.NewService(provider.GetConfigClient().GetValue(appconfig.GRPCAPISubscriptionBuffer).Int())
+
 	return Services{
 		WeatherService: weather_service.NewService(
 			weatherServiceConfig,
+			clients.providers,
 			clients.openMeteoClient,
 			publishers.weather,
 			repositories.WeatherRepo,
+			alertService,
+			subscriptions,
 			metrics.manager,
 		),
+		AlertService:  alertService,
+		Subscriptions: subscriptions,
+		CityService:   cityService,
 	}
 }
@@ -11,9 +11,10 @@ import (
 	appconfig "github.com/meteogo/weather-collector-service/internal/config"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 )
 
 func InitTracer(ctx context.Context, provider config.Provider) {
@@ -32,9 +33,10 @@ func InitTracer(ctx context.Context, provider config.Provider) {
 	}
 
 	res, err := resource.New(ctx,
+		resource.WithSchemaURL(semconv.SchemaURL),
 		resource.WithAttributes(
-			semconv.ServiceNameKey.String(provider.GetConfigClient().GetValue(appconfig.ApplicationName).String()),
-			semconv.DeploymentEnvironmentKey.String(provider.GetConfigClient().GetValue(appconfig.Env).String()),
+			semconv.ServiceName(provider.GetConfigClient().GetValue(appconfig.ApplicationName).String()),
+			semconv.DeploymentEnvironmentName(provider.GetConfigClient().GetValue(appconfig.Env).String()),
 		),
 	)
 	if err != nil {
@@ -48,6 +50,7 @@ func InitTracer(ctx context.Context, provider config.Provider) {
 	)
 
 	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 	logger.Info(ctx, "tracer provider created successfully")
 	closer.Add(func(ctx context.Context) error {
 		logger.Info(ctx, "shutting down tracer provider")
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/closer"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/transport/grpc_api"
+	"github.com/meteogo/weather-collector-service/internal/transport/http_api"
+)
+
+type Transports struct {
+	HTTPAPI  *http_api.Server
+	AdminAPI *http_api.Server
+	GRPCAPI  *grpc_api.Server
+}
+
+func InitTransports(ctx context.Context, provider config.Provider, repositories Repositories, services Services) Transports {
+	httpAPI := http_api.NewServer(
+		provider.GetConfigClient().GetValue(appconfig.HTTPAPIAddress).String(),
+		http_api.NewHandler(repositories.WeatherRepo),
+	)
+	httpAPI.Start(ctx)
+
+	closer.Add(func(ctx context.Context) error {
+		logger.Info(ctx, "stopping http api server")
+		return httpAPI.Stop(ctx)
+	})
+
+	adminAPI := http_api.NewServer(
+		provider.GetConfigClient().GetValue(appconfig.AdminAPIAddress).String(),
+		http_api.NewAdminHandler(services.CityService),
+	)
+	adminAPI.Start(ctx)
+
+	closer.Add(func(ctx context.Context) error {
+		logger.Info(ctx, "stopping admin api server")
+		return adminAPI.Stop(ctx)
+	})
+
+	grpcAPI := grpc_api.NewServer(
+		provider.GetConfigClient().GetValue(appconfig.GRPCAPIAddress).String(),
+		repositories.WeatherRepo,
+		services.Subscriptions,
+	)
+	if err := grpcAPI.Start(ctx); err != nil {
+		panic(err)
+	}
+
+	closer.Add(func(ctx context.Context) error {
+		logger.Info(ctx, "stopping grpc api server")
+		return grpcAPI.Stop(ctx)
+	})
+
+	return Transports{
+		HTTPAPI:  httpAPI,
+		AdminAPI: adminAPI,
+		GRPCAPI:  grpcAPI,
+	}
+}
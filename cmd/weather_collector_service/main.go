@@ -13,6 +13,8 @@ import (
 	"github.com/meteogo/weather-collector-service/internal/closer"
 )
 
+const configPath = ".cfg/values.yaml"
+
 func main() {
 	var (
 		env      = logger.EnvTypeLocal
@@ -23,16 +25,24 @@ func main() {
 	logger.InitLogger(logger.EnvType(env), logLevel)
 	logger.Info(ctx, "logger initialized successfully", slog.Any("env", env), slog.Any("level", logLevel.String()))
 
-	provider := config.NewProvider(".cfg/values.yaml")
+	provider := config.NewProvider(configPath)
 	logger.Info(ctx, "config provider created successfully")
 
+	if len(os.Args) > 1 && os.Args[1] == app.BackfillCommand {
+		runBackfill(ctx, provider)
+		return
+	}
+
 	var (
 		repositories = app.InitRepositories(ctx, provider)
-		clients      = app.InitClients()
-		publishers   = app.InitPublishers(ctx, provider)
+		clients      = app.InitClients(provider)
 		metrics      = app.InitMetrics(ctx)
-		services     = app.InitServices(provider, clients, publishers, repositories, metrics)
-		_            = app.InitSchedulers(ctx, provider, services)
+		publishers   = app.InitPublishers(ctx, provider, metrics)
+		services     = app.InitServices(ctx, provider, clients, publishers, repositories, metrics)
+		ops          = app.InitOps(ctx, provider, repositories, publishers)
+		_            = app.InitTransports(ctx, provider, repositories, services)
+		_            = app.InitSchedulers(ctx, provider, services, ops)
+		_            = app.InitReloader(ctx, provider, configPath, metrics)
 	)
 
 	app.InitTracer(ctx, provider)
@@ -46,3 +56,20 @@ func main() {
 	}
 	logger.Info(ctx, "server gracefully shutdowned")
 }
+
+func runBackfill(ctx context.Context, provider config.Provider) {
+	backfillCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := app.RunBackfill(backfillCtx, provider, os.Args[2:])
+	if closeErr := closer.Close(ctx); closeErr != nil {
+		logger.Error(ctx, "error while closer.Close()", slog.Any("error", closeErr))
+	}
+
+	if err != nil {
+		logger.Error(ctx, "backfill failed", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	logger.Info(ctx, "backfill finished successfully")
+}
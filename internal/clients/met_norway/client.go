@@ -0,0 +1,173 @@
+package met_norway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+const DefaultBaseURL = "https://api.met.no/weatherapi/locationforecast/2.0/compact"
+
+// Client reads the first entry of the MET Norway Locationforecast time series
+// as the current conditions. MET Norway requires every request to carry an
+// identifying User-Agent.
+type Client struct {
+	baseURL    string
+	userAgent  string
+	httpClient *http.Client
+}
+
+func NewMetNorwayClient(baseURL, userAgent string, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		userAgent:  userAgent,
+		httpClient: httpClient,
+	}
+}
+
+func (c *Client) CurrentWeather(ctx context.Context, city weather_service.City, params weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error) {
+	url := fmt.Sprintf("%s?lat=%.4f&lon=%.4f", c.baseURL, city.Coordinates.Lat, city.Coordinates.Long)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return weather_service.CityWeatherCondition{}, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		logger.Error(ctx, "unable to request met norway", slog.Any("coords", city.Coordinates), slog.Any("error", err))
+		return weather_service.CityWeatherCondition{}, unavailable(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logger.Error(ctx, "unable to read met norway response body", slog.Any("coords", city.Coordinates), slog.Any("error", err))
+		return weather_service.CityWeatherCondition{}, unavailable(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return weather_service.CityWeatherCondition{}, &APIError{StatusCode: resp.StatusCode}
+	}
+
+	type LocationforecastResponse struct {
+		Properties struct {
+			Timeseries []struct {
+				Time time.Time `json:"time"`
+				Data struct {
+					Instant struct {
+						Details struct {
+							AirTemperature    *float64 `json:"air_temperature"`
+							RelativeHumidity  *float64 `json:"relative_humidity"`
+							WindSpeed         *float64 `json:"wind_speed"`
+							CloudAreaFraction *float64 `json:"cloud_area_fraction"`
+						} `json:"details"`
+					} `json:"instant"`
+					Next1Hours struct {
+						Summary struct {
+							SymbolCode string `json:"symbol_code"`
+						} `json:"summary"`
+						Details struct {
+							PrecipitationAmount *float64 `json:"precipitation_amount"`
+						} `json:"details"`
+					} `json:"next_1_hours"`
+				} `json:"data"`
+			} `json:"timeseries"`
+		} `json:"properties"`
+	}
+
+	var response LocationforecastResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		logger.Error(ctx, "unable to unmarshal met norway response", slog.Any("coords", city.Coordinates), slog.Any("error", err))
+		return weather_service.CityWeatherCondition{}, err
+	}
+
+	if len(response.Properties.Timeseries) == 0 {
+		return weather_service.CityWeatherCondition{}, errors.New("met norway response has empty timeseries")
+	}
+
+	current := response.Properties.Timeseries[0]
+	details := current.Data.Instant.Details
+	next := current.Data.Next1Hours
+
+	var weatherCode *enums.WeatherCode
+	if code, ok := weatherCodeFromSymbol(next.Summary.SymbolCode); ok {
+		weatherCode = requested(params, enums.MonitoringParamWeatherCode, &code, func(v enums.WeatherCode) enums.WeatherCode { return v })
+	}
+
+	return weather_service.CityWeatherCondition{
+		City:                    city,
+		CapturedAt:              current.Time.UTC(),
+		Temperature:             requested(params, enums.MonitoringParamTemperature, details.AirTemperature, enums.Celsius),
+		RelativeHumidityPercent: requested(params, enums.MonitoringParamRelativeHumidity, details.RelativeHumidity, func(v float64) uint8 { return uint8(v) }),
+		WindSpeed:               requested(params, enums.MonitoringParamWindSpeed, details.WindSpeed, enums.MetersPerSecond),
+		WeatherCode:             weatherCode,
+		CloudCoverPercent:       requested(params, enums.MonitoringParamCloudCover, details.CloudAreaFraction, func(v float64) uint8 { return uint8(v) }),
+		Precipitation:           requested(params, enums.MonitoringParamPrecipitation, next.Details.PrecipitationAmount, enums.Millimeters),
+	}, nil
+}
+
+// requested converts a reported value of a requested param. Params that were
+// not requested are left out like Open-Meteo does, values MET Norway did not
+// report stay nil. Visibility is not served by MET Norway.
+func requested[V, T any](params weather_service.MonitoringParamsMap, param enums.MonitoringParam, value *V, convert func(V) T) *T {
+	if _, ok := params[param]; !ok {
+		return nil
+	}
+
+	return ptr.Map(value, convert)
+}
+
+var symbolWeatherCodes = map[string]enums.WeatherCode{
+	"clearsky":          enums.ClearSky,
+	"fair":              enums.MainlyClear,
+	"partlycloudy":      enums.PartlyCloudy,
+	"cloudy":            enums.Overcast,
+	"fog":               enums.Fog,
+	"lightrain":         enums.RainSlight,
+	"rain":              enums.RainModerate,
+	"heavyrain":         enums.RainHeavy,
+	"lightsleet":        enums.RainSlight,
+	"sleet":             enums.RainModerate,
+	"heavysleet":        enums.RainHeavy,
+	"lightsnow":         enums.SnowFallSlight,
+	"snow":              enums.SnowFallModerate,
+	"heavysnow":         enums.SnowFallHeavy,
+	"lightrainshowers":  enums.RainShowersSlight,
+	"rainshowers":       enums.RainShowersModerate,
+	"heavyrainshowers":  enums.RainShowersViolent,
+	"lightsleetshowers": enums.RainShowersSlight,
+	"sleetshowers":      enums.RainShowersModerate,
+	"heavysleetshowers": enums.RainShowersViolent,
+	"lightsnowshowers":  enums.SnowShowersSlight,
+	"snowshowers":       enums.SnowShowersHeavy,
+	"heavysnowshowers":  enums.SnowShowersHeavy,
+}
+
+// weatherCodeFromSymbol maps a MET Norway symbol code such as
+// "lightrainshowers_day" onto the closest WMO weather code and reports false
+// for a missing or unknown symbol. The WMO subset has no code for sleet, a
+// mix of rain and snow, it is reported as rain of the same intensity. WMO has
+// no separate moderate snow showers, code 86 stands for "moderate or heavy"
+// intensity.
+func weatherCodeFromSymbol(symbol string) (enums.WeatherCode, bool) {
+	symbol, _, _ = strings.Cut(symbol, "_")
+	if strings.Contains(symbol, "thunder") {
+		return enums.ThunderstormSlight, true
+	}
+
+	code, ok := symbolWeatherCodes[symbol]
+	return code, ok
+}
@@ -0,0 +1,232 @@
+package met_norway_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/clients/met_norway"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/stretchr/testify/assert"
+)
+
+const locationforecastResponse = `{
+	"properties": {
+		"timeseries": [
+			{
+				"time": "2025-05-03T13:00:00Z",
+				"data": {
+					"instant": {
+						"details": {
+							"air_temperature": 12.5,
+							"relative_humidity": 61.2,
+							"wind_speed": 2.5,
+							"cloud_area_fraction": 87.5
+						}
+					},
+					"next_1_hours": {
+						"summary": {
+							"symbol_code": "%s"
+						},
+						"details": {
+							"precipitation_amount": 3.0
+						}
+					}
+				}
+			}
+		]
+	}
+}`
+
+var allParams = weather_service.MonitoringParamsMap{
+	enums.MonitoringParamTemperature:      "temperature_2m",
+	enums.MonitoringParamRelativeHumidity: "relative_humidity_2m",
+	enums.MonitoringParamWindSpeed:        "wind_speed_10m",
+	enums.MonitoringParamWeatherCode:      "weather_code",
+	enums.MonitoringParamCloudCover:       "cloud_cover",
+	enums.MonitoringParamPrecipitation:    "precipitation",
+	enums.MonitoringParamVisibility:       "visibility",
+}
+
+func TestMetNorwayClient_CurrentWeather(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	city := weather_service.City{
+		Name: "Berlin",
+		Coordinates: weather_service.Coordinates{
+			Lat:  52.52,
+			Long: 13.41,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		params        weather_service.MonitoringParamsMap
+		wantCondition weather_service.CityWeatherCondition
+		wantErrFunc   assert.ErrorAssertionFunc
+	}{
+		{
+			name:   "happy path",
+			status: http.StatusOK,
+			body:   fmt.Sprintf(locationforecastResponse, "lightrainshowersandthunder_day"),
+			params: allParams,
+			wantCondition: weather_service.CityWeatherCondition{
+				City:                    city,
+				CapturedAt:              time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC),
+				Temperature:             ptr.To(enums.Celsius(12.5)),
+				RelativeHumidityPercent: ptr.To[uint8](61),
+				WindSpeed:               ptr.To(enums.KilometersPerHour(9)),
+				WeatherCode:             ptr.To(enums.ThunderstormSlight),
+				CloudCoverPercent:       ptr.To[uint8](87),
+				Precipitation:           ptr.To(3 * enums.Millimeter),
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:   "only requested params",
+			status: http.StatusOK,
+			body:   fmt.Sprintf(locationforecastResponse, "cloudy"),
+			params: weather_service.MonitoringParamsMap{
+				enums.MonitoringParamTemperature: "temperature_2m",
+			},
+			wantCondition: weather_service.CityWeatherCondition{
+				City:        city,
+				CapturedAt:  time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC),
+				Temperature: ptr.To(enums.Celsius(12.5)),
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:   "missing values and unknown symbol",
+			status: http.StatusOK,
+			body:   `{"properties": {"timeseries": [{"time": "2025-05-03T13:00:00Z", "data": {"instant": {"details": {"air_temperature": 0}}, "next_1_hours": {"summary": {"symbol_code": "volcanicash"}}}}]}}`,
+			params: allParams,
+			wantCondition: weather_service.CityWeatherCondition{
+				City:        city,
+				CapturedAt:  time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC),
+				Temperature: ptr.To(enums.Celsius(0)),
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:   "bad request",
+			status: http.StatusForbidden,
+			body:   `{}`,
+			wantErrFunc: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, weather_service.ErrInvalidRequest)
+			},
+		},
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			body:   `{}`,
+			wantErrFunc: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, weather_service.ErrProviderRateLimited)
+			},
+		},
+		{
+			name:   "unavailable",
+			status: http.StatusServiceUnavailable,
+			body:   `{}`,
+			wantErrFunc: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, weather_service.ErrProviderUnavailable)
+			},
+		},
+		{
+			name:        "empty timeseries",
+			status:      http.StatusOK,
+			body:        `{"properties": {"timeseries": []}}`,
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "test-agent", r.Header.Get("User-Agent"))
+				assert.Equal(t, "52.5200", r.URL.Query().Get("lat"))
+				assert.Equal(t, "13.4100", r.URL.Query().Get("lon"))
+
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := met_norway.NewMetNorwayClient(server.URL, "test-agent", &http.Client{Timeout: time.Second})
+			condition, err := client.CurrentWeather(context.Background(), city, tt.params)
+			if !tt.wantErrFunc(t, err) {
+				t.Fail()
+			}
+
+			assert.Equal(t, tt.wantCondition, condition)
+		})
+	}
+}
+
+func TestMetNorwayClient_CurrentWeather_WeatherCode(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	tests := []struct {
+		symbol          string
+		wantWeatherCode enums.WeatherCode
+	}{
+		{symbol: "clearsky_day", wantWeatherCode: enums.ClearSky},
+		{symbol: "clearsky_night", wantWeatherCode: enums.ClearSky},
+		{symbol: "fair_polartwilight", wantWeatherCode: enums.MainlyClear},
+		{symbol: "partlycloudy_day", wantWeatherCode: enums.PartlyCloudy},
+		{symbol: "cloudy", wantWeatherCode: enums.Overcast},
+		{symbol: "fog", wantWeatherCode: enums.Fog},
+		{symbol: "lightrain", wantWeatherCode: enums.RainSlight},
+		{symbol: "rain", wantWeatherCode: enums.RainModerate},
+		{symbol: "heavyrain", wantWeatherCode: enums.RainHeavy},
+		{symbol: "lightsleet", wantWeatherCode: enums.RainSlight},
+		{symbol: "sleet", wantWeatherCode: enums.RainModerate},
+		{symbol: "heavysleet", wantWeatherCode: enums.RainHeavy},
+		{symbol: "lightsnow", wantWeatherCode: enums.SnowFallSlight},
+		{symbol: "snow", wantWeatherCode: enums.SnowFallModerate},
+		{symbol: "heavysnow", wantWeatherCode: enums.SnowFallHeavy},
+		{symbol: "lightrainshowers_day", wantWeatherCode: enums.RainShowersSlight},
+		{symbol: "rainshowers_night", wantWeatherCode: enums.RainShowersModerate},
+		{symbol: "heavyrainshowers_day", wantWeatherCode: enums.RainShowersViolent},
+		{symbol: "lightsleetshowers_day", wantWeatherCode: enums.RainShowersSlight},
+		{symbol: "sleetshowers_night", wantWeatherCode: enums.RainShowersModerate},
+		{symbol: "heavysleetshowers_day", wantWeatherCode: enums.RainShowersViolent},
+		{symbol: "lightsnowshowers_day", wantWeatherCode: enums.SnowShowersSlight},
+		{symbol: "snowshowers_night", wantWeatherCode: enums.SnowShowersHeavy},
+		{symbol: "heavysnowshowers_day", wantWeatherCode: enums.SnowShowersHeavy},
+		{symbol: "rainandthunder", wantWeatherCode: enums.ThunderstormSlight},
+		{symbol: "heavysleetshowersandthunder_day", wantWeatherCode: enums.ThunderstormSlight},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(fmt.Sprintf(locationforecastResponse, tt.symbol)))
+			}))
+			defer server.Close()
+
+			client := met_norway.NewMetNorwayClient(server.URL, "test-agent", &http.Client{Timeout: time.Second})
+			condition, err := client.CurrentWeather(context.Background(), weather_service.City{Name: "Berlin"}, allParams)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, ptr.To(tt.wantWeatherCode), condition.WeatherCode)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package met_norway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+// APIError is returned for every non 200 response.
+type APIError struct {
+	StatusCode int
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("met norway responded with status %d", e.StatusCode)
+}
+
+// Unwrap maps the status code onto the provider errors of the weather service.
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests:
+		return weather_service.ErrProviderRateLimited
+	case e.StatusCode >= http.StatusInternalServerError:
+		return weather_service.ErrProviderUnavailable
+	case e.StatusCode >= http.StatusBadRequest:
+		return weather_service.ErrInvalidRequest
+	default:
+		return nil
+	}
+}
+
+// unavailable marks a request that got no response, such as a network error
+// or a timeout, as a failure of the provider. A cancelled request says
+// nothing about the provider and is returned as is.
+func unavailable(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", weather_service.ErrProviderUnavailable, err)
+}
@@ -3,6 +3,8 @@ package open_meteo
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
@@ -10,81 +12,302 @@ import (
 
 	"github.com/meteogo/logger/pkg/logger"
 	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
 	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type OpenMeteoURLGenerator interface {
-	GenerateURL(coordinates weather_service.Coordinates, params weather_service.MonitoringParamsMap) string
+	GenerateURL(coordinates []weather_service.Coordinates, params weather_service.MonitoringParamsMap) string
+	GenerateForecastURL(coordinates weather_service.Coordinates, params weather_service.ForecastParams) string
+	GenerateArchiveURL(coordinates weather_service.Coordinates, params weather_service.MonitoringParamsMap, from, to time.Time) string
 }
 
 type Client struct {
 	urlGenerator OpenMeteoURLGenerator
+	httpClient   *http.Client
+	retryPolicy  RetryPolicy
 }
 
-func NewOpenMeteoClient(urlGenerator OpenMeteoURLGenerator) *Client {
+func NewOpenMeteoClient(urlGenerator OpenMeteoURLGenerator, httpClient *http.Client, retryPolicy RetryPolicy) *Client {
 	return &Client{
 		urlGenerator: urlGenerator,
+		httpClient:   httpClient,
+		retryPolicy:  retryPolicy,
 	}
 }
 
 func (c *Client) CurrentWeather(ctx context.Context, city weather_service.City, params weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error) {
-	url := c.urlGenerator.GenerateURL(city.Coordinates, params)
-
-	resp, err := http.Get(url)
+	conditions, err := c.CurrentWeatherBatch(ctx, weather_service.ReportedCities{city}, params)
 	if err != nil {
-		logger.Error(ctx, "unable to http.Get", slog.Any("coords", city.Coordinates), slog.Any("params", params), slog.Any("error", err))
 		return weather_service.CityWeatherCondition{}, err
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	return conditions[0], nil
+}
+
+// CurrentWeatherBatch fetches current conditions for all cities with a single
+// request. Open-Meteo answers a multi-location request with an array that
+// keeps the order of the requested coordinates.
+func (c *Client) CurrentWeatherBatch(ctx context.Context, cities weather_service.ReportedCities, params weather_service.MonitoringParamsMap) (weather_service.CityWeatherConditions, error) {
+	if len(cities) == 0 {
+		return weather_service.CityWeatherConditions{}, nil
+	}
+
+	coordinates := make([]weather_service.Coordinates, 0, len(cities))
+	names := make([]string, 0, len(cities))
+	for _, city := range cities {
+		coordinates = append(coordinates, city.Coordinates)
+		names = append(names, city.Name)
+	}
+
+	ctx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.CurrentWeatherBatch]", c), trace.WithAttributes(telemetry.Cities(names...)...))
+	defer span.End()
+
+	url := c.urlGenerator.GenerateURL(coordinates, params)
+
+	body, err := c.get(ctx, url)
 	if err != nil {
-		logger.Error(ctx, "unable to read response body", slog.Any("coords", city.Coordinates), slog.Any("error", err))
-		return weather_service.CityWeatherCondition{}, err
+		return nil, err
+	}
+
+	responses, err := decodeLocations[currentResponse](body)
+	if err != nil {
+		logger.Error(ctx, "unable to unmarshal response", slog.Any("coords", coordinates), slog.Any("error", err))
+		return nil, err
+	}
+
+	if len(responses) != len(cities) {
+		return nil, fmt.Errorf("open-meteo returned %d locations for %d requested", len(responses), len(cities))
+	}
+
+	conditions := make(weather_service.CityWeatherConditions, 0, len(cities))
+	for i, response := range responses {
+		var rawTime string
+		if err := json.Unmarshal(response.Current["time"], &rawTime); err != nil {
+			logger.Error(ctx, "unable to decode time", slog.Any("coords", cities[i].Coordinates), slog.Any("error", err))
+			return nil, err
+		}
+
+		capturedAt, err := time.Parse("2006-01-02T15:04", rawTime)
+		if err != nil {
+			logger.Error(ctx, "unable to parse time", slog.Any("time", rawTime), slog.Any("error", err))
+			return nil, err
+		}
+
+		values, err := decodeMeasurements(params, response.Current, response.CurrentUnits)
+		if err != nil {
+			logger.Error(ctx, "unable to decode current measurements", slog.Any("coords", cities[i].Coordinates), slog.Any("error", err))
+			return nil, err
+		}
+
+		city := weather_service.City{
+			ID:   cities[i].ID,
+			Name: cities[i].Name,
+			Coordinates: weather_service.Coordinates{
+				Lat:  response.Latitude,
+				Long: response.Longitude,
+			},
+		}
+
+		conditions = append(conditions, values.condition(city, capturedAt))
+	}
+
+	return conditions, nil
+}
+
+func (c *Client) Forecast(ctx context.Context, city weather_service.City, params weather_service.ForecastParams) (weather_service.CityWeatherForecast, error) {
+	ctx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.Forecast]", c), trace.WithAttributes(telemetry.Cities(city.Name)...))
+	defer span.End()
+
+	url := c.urlGenerator.GenerateForecastURL(city.Coordinates, params)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return weather_service.CityWeatherForecast{}, err
 	}
 
-	type CurrentWeatherResponse struct {
-		Latitude  float64 `json:"latitude"`
-		Longitude float64 `json:"longitude"`
-		Current   struct {
-			Time               string  `json:"time"`
-			Temperature2m      float64 `json:"temperature_2m"`
-			RelativeHumidity2m uint8   `json:"relative_humidity_2m"`
-			WindSpeed10m       float64 `json:"wind_speed_10m"`
-			WeatherCode        int     `json:"weather_code"`
-			CloudCover         uint8   `json:"cloud_cover"`
-			Precipitation      float64 `json:"precipitation"`
-			Visibility         float64 `json:"visibility"`
-		} `json:"current"`
+	type ForecastResponse struct {
+		Latitude    float64                    `json:"latitude"`
+		Longitude   float64                    `json:"longitude"`
+		HourlyUnits map[string]string          `json:"hourly_units"`
+		Hourly      map[string]json.RawMessage `json:"hourly"`
+		DailyUnits  map[string]string          `json:"daily_units"`
+		Daily       map[string]json.RawMessage `json:"daily"`
 	}
 
-	var response CurrentWeatherResponse
+	var response ForecastResponse
 	if err := json.Unmarshal(body, &response); err != nil {
-		logger.Error(ctx, "unable to unmarshal response", slog.Any("coords", city.Coordinates), slog.Any("error", err))
-		return weather_service.CityWeatherCondition{}, err
+		logger.Error(ctx, "unable to unmarshal forecast response", slog.Any("coords", city.Coordinates), slog.Any("error", err))
+		return weather_service.CityWeatherForecast{}, err
 	}
 
-	capturedAt, err := time.Parse("2006-01-02T15:04", response.Current.Time)
+	hourlyTimes, hourlySteps, err := decodeSeriesMeasurements(params.Hourly, response.Hourly, response.HourlyUnits)
 	if err != nil {
-		logger.Error(ctx, "unable to parse time", slog.Any("time", response.Current.Time), slog.Any("error", err))
-		return weather_service.CityWeatherCondition{}, err
+		logger.Error(ctx, "unable to parse hourly forecast", slog.Any("coords", city.Coordinates), slog.Any("error", err))
+		return weather_service.CityWeatherForecast{}, err
+	}
+
+	hourly := make([]weather_service.HourlyForecast, 0, len(hourlySteps))
+	for i, step := range hourlySteps {
+		hourly = append(hourly, step.hourlyForecast(hourlyTimes[i]))
+	}
+
+	dailyDates, dailySteps, err := decodeDailyMeasurements(params.Daily, response.Daily, response.DailyUnits)
+	if err != nil {
+		logger.Error(ctx, "unable to parse daily forecast", slog.Any("coords", city.Coordinates), slog.Any("error", err))
+		return weather_service.CityWeatherForecast{}, err
 	}
 
-	return weather_service.CityWeatherCondition{
+	daily := make([]weather_service.DailyForecast, 0, len(dailySteps))
+	for i, step := range dailySteps {
+		daily = append(daily, step.dailyForecast(dailyDates[i]))
+	}
+
+	return weather_service.CityWeatherForecast{
 		City: weather_service.City{
+			ID:   city.ID,
 			Name: city.Name,
 			Coordinates: weather_service.Coordinates{
 				Lat:  response.Latitude,
 				Long: response.Longitude,
 			},
 		},
-		CapturedAt:              capturedAt,
-		Temperature:             response.Current.Temperature2m,
-		RelativeHumidityPercent: response.Current.RelativeHumidity2m,
-		WindSpeed:               response.Current.WindSpeed10m,
-		WeatherCode:             enums.WeatherCode(response.Current.WeatherCode),
-		CloudCoverPercent:       response.Current.CloudCover,
-		Precipitation:           enums.Length(response.Current.Precipitation) * enums.Millimeter,
-		Visibility:              enums.Length(response.Current.Visibility) * enums.Meter,
+		CapturedAt: time.Now().UTC(),
+		Hourly:     hourly,
+		Daily:      daily,
 	}, nil
 }
+
+func (c *Client) ArchiveWeather(
+	ctx context.Context,
+	city weather_service.City,
+	params weather_service.MonitoringParamsMap,
+	from, to time.Time,
+) (weather_service.CityWeatherConditions, error) {
+	ctx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.ArchiveWeather]", c), trace.WithAttributes(telemetry.Cities(city.Name)...))
+	defer span.End()
+
+	url := c.urlGenerator.GenerateArchiveURL(city.Coordinates, params, from, to)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var response archiveResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		logger.Error(ctx, "unable to unmarshal archive response", slog.Any("coords", city.Coordinates), slog.Any("error", err))
+		return nil, err
+	}
+
+	times, steps, err := decodeSeriesMeasurements(params, response.Hourly, response.HourlyUnits)
+	if err != nil {
+		logger.Error(ctx, "unable to parse archive series", slog.Any("coords", city.Coordinates), slog.Any("error", err))
+		return nil, err
+	}
+
+	archiveCity := weather_service.City{
+		ID:   city.ID,
+		Name: city.Name,
+		Coordinates: weather_service.Coordinates{
+			Lat:  response.Latitude,
+			Long: response.Longitude,
+		},
+	}
+
+	conditions := make(weather_service.CityWeatherConditions, 0, len(steps))
+	for i, values := range steps {
+		condition := values.condition(archiveCity, times[i])
+		condition.Provider = enums.ProviderOpenMeteo
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
+
+// get retries transport errors, 429 and 5xx responses according to the retry
+// policy. A Retry-After longer than the policy allows ends the retries, the
+// caller gets the rate limit error instead of a stalled worker.
+func (c *Client) get(ctx context.Context, url string) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		body, err := c.do(ctx, url, attempt)
+		if err == nil {
+			return body, nil
+		}
+
+		retryAfter, retryable := retryDelay(ctx, err)
+		if !retryable || attempt >= c.retryPolicy.MaxRetries || (c.retryPolicy.MaxDelay > 0 && retryAfter > c.retryPolicy.MaxDelay) {
+			return nil, err
+		}
+
+		delay := c.retryPolicy.backoff(attempt, retryAfter)
+		logger.Warn(ctx, "open-meteo request failed, retrying", slog.String("url", url), slog.Int("attempt", attempt+1), slog.Duration("delay", delay), slog.Any("error", err))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// do sends a single request, every attempt gets its own client span.
+func (c *Client) do(ctx context.Context, url string, attempt int) ([]byte, error) {
+	ctx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.do]", c), trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, telemetry.Fail(span, err)
+	}
+
+	span.SetAttributes(
+		semconv.HTTPRequestMethodGet,
+		semconv.URLFull(url),
+		semconv.ServerAddress(req.URL.Hostname()),
+	)
+	if attempt > 0 {
+		span.SetAttributes(semconv.HTTPRequestResendCount(attempt))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		logger.Error(ctx, "unable to request open-meteo", slog.String("url", url), slog.Any("error", err))
+		return nil, telemetry.Fail(span, unavailable(err))
+	}
+	defer resp.Body.Close()
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logger.Error(ctx, "unable to read response body", slog.String("url", url), slog.Any("error", err))
+		return nil, telemetry.Fail(span, unavailable(err))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		apiErr := newAPIError(resp, body)
+		logger.Error(ctx, "open-meteo responded with error", slog.String("url", url), slog.Int("status", apiErr.StatusCode), slog.String("reason", apiErr.Reason))
+		return nil, telemetry.Fail(span, apiErr)
+	}
+
+	return body, nil
+}
+
+func retryDelay(ctx context.Context, err error) (time.Duration, bool) {
+	if ctx.Err() != nil {
+		return 0, false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.RetryAfter, apiErr.retryable()
+	}
+
+	return 0, true
+}
@@ -0,0 +1,499 @@
+package open_meteo_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/clients/open_meteo"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubURLGenerator struct {
+	url string
+}
+
+func (g stubURLGenerator) GenerateURL(_ []weather_service.Coordinates, _ weather_service.MonitoringParamsMap) string {
+	return g.url
+}
+
+func (g stubURLGenerator) GenerateForecastURL(_ weather_service.Coordinates, _ weather_service.ForecastParams) string {
+	return g.url
+}
+
+func (g stubURLGenerator) GenerateArchiveURL(_ weather_service.Coordinates, _ weather_service.MonitoringParamsMap, _, _ time.Time) string {
+	return g.url
+}
+
+var (
+	berlin = weather_service.City{
+		Name: "Berlin",
+		Coordinates: weather_service.Coordinates{
+			Lat:  52.52,
+			Long: 13.41,
+		},
+	}
+
+	currentParams = weather_service.MonitoringParamsMap{
+		enums.MonitoringParamTemperature:      "temperature_2m",
+		enums.MonitoringParamRelativeHumidity: "relative_humidity_2m",
+		enums.MonitoringParamWindSpeed:        "wind_speed_10m",
+		enums.MonitoringParamWeatherCode:      "weather_code",
+		enums.MonitoringParamCloudCover:       "cloud_cover",
+		enums.MonitoringParamPrecipitation:    "precipitation",
+		enums.MonitoringParamVisibility:       "visibility",
+	}
+
+	paris = weather_service.City{
+		Name: "Paris",
+		Coordinates: weather_service.Coordinates{
+			Lat:  48.86,
+			Long: 2.35,
+		},
+	}
+)
+
+const (
+	berlinCurrentResponse = `{
+		"latitude": 52.52,
+		"longitude": 13.42,
+		"current": {
+			"time": "2025-05-03T13:00",
+			"temperature_2m": 12.5,
+			"relative_humidity_2m": 40,
+			"wind_speed_10m": 7.2,
+			"weather_code": 3,
+			"cloud_cover": 100,
+			"precipitation": 2,
+			"visibility": 24140
+		}
+	}`
+
+	parisCurrentResponse = `{
+		"latitude": 48.86,
+		"longitude": 2.34,
+		"current": {
+			"time": "2025-05-03T13:15",
+			"temperature_2m": 15.1,
+			"relative_humidity_2m": 52,
+			"wind_speed_10m": 3.1,
+			"weather_code": 61,
+			"cloud_cover": 80,
+			"precipitation": 4,
+			"visibility": 12000
+		}
+	}`
+)
+
+func TestClient_CurrentWeatherBatch(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	var (
+		berlinCondition = weather_service.CityWeatherCondition{
+			City: weather_service.City{
+				Name: "Berlin",
+				Coordinates: weather_service.Coordinates{
+					Lat:  52.52,
+					Long: 13.42,
+				},
+			},
+			CapturedAt:              time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC),
+			Temperature:             ptr.To(enums.Celsius(12.5)),
+			RelativeHumidityPercent: ptr.To[uint8](40),
+			WindSpeed:               ptr.To(enums.KilometersPerHour(7.2)),
+			WeatherCode:             ptr.To(enums.Overcast),
+			CloudCoverPercent:       ptr.To[uint8](100),
+			Precipitation:           ptr.To(2 * enums.Millimeter),
+			Visibility:              ptr.To(24140 * enums.Meter),
+		}
+
+		parisCondition = weather_service.CityWeatherCondition{
+			City: weather_service.City{
+				Name: "Paris",
+				Coordinates: weather_service.Coordinates{
+					Lat:  48.86,
+					Long: 2.34,
+				},
+			},
+			CapturedAt:              time.Date(2025, time.May, 3, 13, 15, 0, 0, time.UTC),
+			Temperature:             ptr.To(enums.Celsius(15.1)),
+			RelativeHumidityPercent: ptr.To[uint8](52),
+			WindSpeed:               ptr.To(enums.KilometersPerHour(3.1)),
+			WeatherCode:             ptr.To(enums.RainSlight),
+			CloudCoverPercent:       ptr.To[uint8](80),
+			Precipitation:           ptr.To(4 * enums.Millimeter),
+			Visibility:              ptr.To(12 * enums.Kilometer),
+		}
+	)
+
+	tests := []struct {
+		name           string
+		cities         weather_service.ReportedCities
+		params         weather_service.MonitoringParamsMap
+		body           string
+		wantConditions weather_service.CityWeatherConditions
+		wantErrFunc    assert.ErrorAssertionFunc
+	}{
+		{
+			name:           "single location object",
+			cities:         weather_service.ReportedCities{berlin},
+			params:         currentParams,
+			body:           berlinCurrentResponse,
+			wantConditions: weather_service.CityWeatherConditions{berlinCondition},
+			wantErrFunc:    assert.NoError,
+		},
+		{
+			name:           "multiple locations array",
+			cities:         weather_service.ReportedCities{berlin, paris},
+			params:         currentParams,
+			body:           "[" + berlinCurrentResponse + "," + parisCurrentResponse + "]",
+			wantConditions: weather_service.CityWeatherConditions{berlinCondition, parisCondition},
+			wantErrFunc:    assert.NoError,
+		},
+		{
+			name:   "not requested variables are absent",
+			cities: weather_service.ReportedCities{berlin},
+			params: weather_service.MonitoringParamsMap{
+				enums.MonitoringParamTemperature: "temperature_2m",
+				enums.MonitoringParamWeatherCode: "weather_code",
+			},
+			body: berlinCurrentResponse,
+			wantConditions: weather_service.CityWeatherConditions{{
+				City:        berlinCondition.City,
+				CapturedAt:  berlinCondition.CapturedAt,
+				Temperature: ptr.To(enums.Celsius(12.5)),
+				WeatherCode: ptr.To(enums.Overcast),
+			}},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:   "null and missing fields are absent",
+			cities: weather_service.ReportedCities{berlin},
+			params: currentParams,
+			body: `{
+				"latitude": 52.52,
+				"longitude": 13.42,
+				"current": {
+					"time": "2025-05-03T13:00",
+					"temperature_2m": null,
+					"relative_humidity_2m": 40
+				}
+			}`,
+			wantConditions: weather_service.CityWeatherConditions{{
+				City:                    berlinCondition.City,
+				CapturedAt:              berlinCondition.CapturedAt,
+				RelativeHumidityPercent: ptr.To[uint8](40),
+			}},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:   "sub-millimetre precipitation",
+			cities: weather_service.ReportedCities{berlin},
+			params: currentParams,
+			body: `{
+				"latitude": 52.52,
+				"longitude": 13.42,
+				"current": {
+					"time": "2025-05-03T13:00",
+					"precipitation": 0.4
+				}
+			}`,
+			wantConditions: weather_service.CityWeatherConditions{{
+				City:          berlinCondition.City,
+				CapturedAt:    berlinCondition.CapturedAt,
+				Precipitation: ptr.To(400 * enums.Micrometer),
+			}},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:   "imperial units are normalised",
+			cities: weather_service.ReportedCities{berlin},
+			params: currentParams,
+			body: `{
+				"latitude": 52.52,
+				"longitude": 13.42,
+				"current_units": {
+					"time": "iso8601",
+					"temperature_2m": "°F",
+					"wind_speed_10m": "mp/h",
+					"precipitation": "inch",
+					"visibility": "ft"
+				},
+				"current": {
+					"time": "2025-05-03T13:00",
+					"temperature_2m": 50,
+					"wind_speed_10m": 10,
+					"precipitation": 0.1,
+					"visibility": 1000
+				}
+			}`,
+			wantConditions: weather_service.CityWeatherConditions{{
+				City:          berlinCondition.City,
+				CapturedAt:    berlinCondition.CapturedAt,
+				Temperature:   ptr.To(enums.Celsius(10)),
+				WindSpeed:     ptr.To(enums.KilometersPerHour(16.09344)),
+				Precipitation: ptr.To(2540 * enums.Micrometer),
+				Visibility:    ptr.To(enums.Meters(304.8)),
+			}},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:   "fractional value of integer variable",
+			cities: weather_service.ReportedCities{berlin},
+			params: currentParams,
+			body: `{
+				"latitude": 52.52,
+				"longitude": 13.42,
+				"current": {
+					"time": "2025-05-03T13:00",
+					"weather_code": 3.5
+				}
+			}`,
+			wantErrFunc: assert.Error,
+		},
+		{
+			name:        "locations count mismatch",
+			cities:      weather_service.ReportedCities{berlin, paris},
+			params:      currentParams,
+			body:        "[" + berlinCurrentResponse + "]",
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := open_meteo.NewOpenMeteoClient(stubURLGenerator{url: server.URL}, server.Client(), open_meteo.RetryPolicy{})
+			conditions, err := client.CurrentWeatherBatch(context.Background(), tt.cities, tt.params)
+			if !tt.wantErrFunc(t, err) {
+				t.Fail()
+			}
+
+			assert.Equal(t, tt.wantConditions, conditions)
+		})
+	}
+}
+
+func TestClient_CurrentWeatherErrors(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	type response struct {
+		status     int
+		retryAfter string
+		body       string
+	}
+
+	retryPolicy := open_meteo.RetryPolicy{
+		MaxRetries: 2,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   10 * time.Millisecond,
+	}
+
+	tests := []struct {
+		name         string
+		responses    []response
+		wantRequests int32
+		wantErrIs    error
+		wantReason   string
+	}{
+		{
+			name: "retries rate limit and server errors",
+			responses: []response{
+				{status: http.StatusTooManyRequests, retryAfter: "0"},
+				{status: http.StatusBadGateway},
+				{status: http.StatusOK, body: berlinCurrentResponse},
+			},
+			wantRequests: 3,
+		},
+		{
+			name: "bad request is not retried",
+			responses: []response{
+				{status: http.StatusBadRequest, body: `{"error": true, "reason": "Latitude must be in range of -90 to 90°."}`},
+			},
+			wantRequests: 1,
+			wantErrIs:    weather_service.ErrInvalidRequest,
+			wantReason:   "Latitude must be in range of -90 to 90°.",
+		},
+		{
+			name: "retries exhausted",
+			responses: []response{
+				{status: http.StatusServiceUnavailable},
+				{status: http.StatusServiceUnavailable},
+				{status: http.StatusServiceUnavailable},
+			},
+			wantRequests: 3,
+			wantErrIs:    weather_service.ErrProviderUnavailable,
+		},
+		{
+			name: "retry after longer than max delay",
+			responses: []response{
+				{status: http.StatusTooManyRequests, retryAfter: "60", body: `{"error": true, "reason": "Minutely API request limit exceeded."}`},
+			},
+			wantRequests: 1,
+			wantErrIs:    weather_service.ErrProviderRateLimited,
+			wantReason:   "Minutely API request limit exceeded.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				resp := tt.responses[requests.Add(1)-1]
+				if resp.retryAfter != "" {
+					w.Header().Set("Retry-After", resp.retryAfter)
+				}
+
+				w.WriteHeader(resp.status)
+				_, _ = w.Write([]byte(resp.body))
+			}))
+			defer server.Close()
+
+			client := open_meteo.NewOpenMeteoClient(stubURLGenerator{url: server.URL}, server.Client(), retryPolicy)
+			_, err := client.CurrentWeather(context.Background(), berlin, currentParams)
+
+			assert.Equal(t, tt.wantRequests, requests.Load())
+			if tt.wantErrIs == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErrIs)
+
+			var apiErr *open_meteo.APIError
+			if assert.ErrorAs(t, err, &apiErr) {
+				assert.Equal(t, tt.wantReason, apiErr.Reason)
+			}
+		})
+	}
+}
+
+func TestClient_CurrentWeatherContextCancelled(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	retryPolicy := open_meteo.RetryPolicy{
+		MaxRetries: 5,
+		BaseDelay:  time.Second,
+		MaxDelay:   time.Minute,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := open_meteo.NewOpenMeteoClient(stubURLGenerator{url: server.URL}, server.Client(), retryPolicy)
+
+	start := time.Now()
+	_, err := client.CurrentWeather(ctx, berlin, nil)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorIs(t, err, weather_service.ErrProviderUnavailable)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClient_CurrentWeatherTimeout(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	httpClient := server.Client()
+	httpClient.Timeout = 20 * time.Millisecond
+
+	client := open_meteo.NewOpenMeteoClient(stubURLGenerator{url: server.URL}, httpClient, open_meteo.RetryPolicy{})
+	_, err := client.CurrentWeather(context.Background(), berlin, currentParams)
+
+	assert.ErrorIs(t, err, weather_service.ErrProviderUnavailable)
+}
+
+func TestClient_Forecast(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	const response = `{
+		"latitude": 52.52,
+		"longitude": 13.42,
+		"hourly_units": {"temperature_2m": "°F", "precipitation": "mm"},
+		"hourly": {
+			"time": ["2025-05-03T13:00", "2025-05-03T14:00"],
+			"temperature_2m": [50, null],
+			"precipitation": [0.4]
+		},
+		"daily_units": {"temperature_2m_max": "°C"},
+		"daily": {
+			"time": ["2025-05-03"],
+			"temperature_2m_max": [17.1],
+			"weather_code": [null]
+		}
+	}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	params := weather_service.ForecastParams{
+		Hourly: weather_service.MonitoringParamsMap{
+			enums.MonitoringParamTemperature:   "temperature_2m",
+			enums.MonitoringParamPrecipitation: "precipitation",
+		},
+		Daily: weather_service.DailyParamsMap{
+			enums.DailyParamTemperatureMax: "temperature_2m_max",
+			enums.DailyParamWeatherCode:    "weather_code",
+		},
+		Days: 1,
+	}
+
+	client := open_meteo.NewOpenMeteoClient(stubURLGenerator{url: server.URL}, server.Client(), open_meteo.RetryPolicy{})
+	forecast, err := client.Forecast(context.Background(), berlin, params)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []weather_service.HourlyForecast{
+		{
+			Time:          time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC),
+			Temperature:   ptr.To(enums.Celsius(10)),
+			Precipitation: ptr.To(enums.Millimeters(0.4)),
+		},
+		{
+			Time: time.Date(2025, time.May, 3, 14, 0, 0, 0, time.UTC),
+		},
+	}, forecast.Hourly)
+
+	assert.Equal(t, []weather_service.DailyForecast{
+		{
+			Date:           time.Date(2025, time.May, 3, 0, 0, 0, 0, time.UTC),
+			TemperatureMax: ptr.To(enums.Celsius(17.1)),
+		},
+	}, forecast.Daily)
+}
@@ -0,0 +1,95 @@
+package open_meteo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+// APIError is returned for every non 200 response. Open-Meteo reports
+// failures as {"error": true, "reason": "..."}, the reason is kept as is.
+type APIError struct {
+	StatusCode int
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("open-meteo responded with status %d", e.StatusCode)
+	}
+
+	return fmt.Sprintf("open-meteo responded with status %d: %s", e.StatusCode, e.Reason)
+}
+
+// Unwrap maps the status code onto the provider errors of the weather service.
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests:
+		return weather_service.ErrProviderRateLimited
+	case e.StatusCode >= http.StatusInternalServerError:
+		return weather_service.ErrProviderUnavailable
+	case e.StatusCode >= http.StatusBadRequest:
+		return weather_service.ErrInvalidRequest
+	default:
+		return nil
+	}
+}
+
+// unavailable marks a request that got no response, such as a network error
+// or a timeout, as a failure of the provider. A cancelled request says
+// nothing about the provider and is returned as is.
+func unavailable(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", weather_service.ErrProviderUnavailable, err)
+}
+
+func (e *APIError) retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	type ErrorResponse struct {
+		Error  bool   `json:"error"`
+		Reason string `json:"reason"`
+	}
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+
+	var response ErrorResponse
+	if err := json.Unmarshal(body, &response); err == nil && response.Error {
+		apiErr.Reason = response.Reason
+	}
+
+	return apiErr
+}
+
+// parseRetryAfter accepts both forms allowed by RFC 9110: delay in seconds
+// and an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+
+	return 0
+}
@@ -0,0 +1,33 @@
+package open_meteo
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// backoff returns the delay before the given retry attempt, counted from zero.
+// The delay grows exponentially up to MaxDelay and is drawn uniformly from
+// [delay/2, delay) so that concurrent workers do not retry in lockstep.
+// A Retry-After sent by the server takes precedence when it is longer.
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	delay := p.BaseDelay
+	for i := 0; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 {
+		delay = min(delay, p.MaxDelay)
+	}
+
+	if delay > 1 {
+		delay = delay/2 + rand.N(delay/2)
+	}
+
+	return max(delay, retryAfter)
+}
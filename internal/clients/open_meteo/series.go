@@ -0,0 +1,267 @@
+package open_meteo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+type currentResponse struct {
+	Latitude     float64                    `json:"latitude"`
+	Longitude    float64                    `json:"longitude"`
+	CurrentUnits map[string]string          `json:"current_units"`
+	Current      map[string]json.RawMessage `json:"current"`
+}
+
+type archiveResponse struct {
+	Latitude    float64                    `json:"latitude"`
+	Longitude   float64                    `json:"longitude"`
+	HourlyUnits map[string]string          `json:"hourly_units"`
+	Hourly      map[string]json.RawMessage `json:"hourly"`
+}
+
+// measurements holds the decoded values of the requested variables. A param
+// is missing when it was not requested or Open-Meteo reported it as null.
+type measurements map[enums.MonitoringParam]float64
+
+// decodeMeasurements reads every requested variable from a "current" block.
+// The config keeps the field requested for each param, the variable registry
+// tells how to read it. Values are normalised from the units Open-Meteo
+// reports next to the block into the canonical unit of the variable.
+func decodeMeasurements(params weather_service.MonitoringParamsMap, values map[string]json.RawMessage, units map[string]string) (measurements, error) {
+	result := make(measurements, len(params))
+	for param, field := range params {
+		variable, ok := enums.LookupVariable(param)
+		if !ok {
+			return nil, fmt.Errorf("unknown monitoring param %q", param)
+		}
+
+		raw, ok := values[field]
+		if !ok {
+			continue
+		}
+
+		var value *float64
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("unable to decode %s: %w", field, err)
+		}
+
+		if value == nil {
+			continue
+		}
+
+		if err := checkType(variable.Type, *value); err != nil {
+			return nil, fmt.Errorf("unable to decode %s: %w", field, err)
+		}
+
+		normalized, err := responseUnit(units[field]).ToCanonical(*value, variable.Unit)
+		if err != nil {
+			return nil, fmt.Errorf("unable to normalise %s: %w", field, err)
+		}
+
+		result[param] = normalized
+	}
+
+	return result, nil
+}
+
+// decodeSeriesMeasurements is decodeMeasurements for the hourly series of the
+// archive and forecast APIs, producing one set of measurements per time step.
+func decodeSeriesMeasurements(params weather_service.MonitoringParamsMap, series map[string]json.RawMessage, units map[string]string) ([]time.Time, []measurements, error) {
+	times, steps, err := decodeSeries(params, monitoringVariable, series, units, "2006-01-02T15:04")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]measurements, 0, len(steps))
+	for _, step := range steps {
+		result = append(result, measurements(step))
+	}
+
+	return times, result, nil
+}
+
+// dailyMeasurements holds the decoded values of the requested daily params of
+// a single day, a param is missing like in measurements.
+type dailyMeasurements map[enums.DailyParam]float64
+
+// decodeDailyMeasurements reads the daily series of the forecast API.
+func decodeDailyMeasurements(params weather_service.DailyParamsMap, series map[string]json.RawMessage, units map[string]string) ([]time.Time, []dailyMeasurements, error) {
+	times, steps, err := decodeSeries(params, dailyVariable, series, units, time.DateOnly)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]dailyMeasurements, 0, len(steps))
+	for _, step := range steps {
+		result = append(result, dailyMeasurements(step))
+	}
+
+	return times, result, nil
+}
+
+func monitoringVariable(param enums.MonitoringParam) (enums.Unit, enums.ValueType, bool) {
+	variable, ok := enums.LookupVariable(param)
+	return variable.Unit, variable.Type, ok
+}
+
+func dailyVariable(param enums.DailyParam) (enums.Unit, enums.ValueType, bool) {
+	variable, ok := enums.LookupDailyVariable(param)
+	return variable.Unit, variable.Type, ok
+}
+
+// decodeSeries reads every requested variable of a series block. A step
+// leaves a param out when its series was not returned, is shorter than the
+// time axis or holds null.
+func decodeSeries[P ~string](
+	params map[P]string,
+	lookup func(P) (enums.Unit, enums.ValueType, bool),
+	series map[string]json.RawMessage,
+	units map[string]string,
+	layout string,
+) ([]time.Time, []map[P]float64, error) {
+	var rawTimes []string
+	if err := json.Unmarshal(series["time"], &rawTimes); err != nil {
+		return nil, nil, fmt.Errorf("unable to decode time: %w", err)
+	}
+
+	times := make([]time.Time, 0, len(rawTimes))
+	steps := make([]map[P]float64, 0, len(rawTimes))
+	for _, rawTime := range rawTimes {
+		t, err := time.Parse(layout, rawTime)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse time %q: %w", rawTime, err)
+		}
+
+		times = append(times, t)
+		steps = append(steps, make(map[P]float64, len(params)))
+	}
+
+	for param, field := range params {
+		unit, valueType, ok := lookup(param)
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown param %q", param)
+		}
+
+		raw, ok := series[field]
+		if !ok {
+			continue
+		}
+
+		var values []*float64
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return nil, nil, fmt.Errorf("unable to decode %s: %w", field, err)
+		}
+
+		for i, value := range values {
+			if i >= len(steps) || value == nil {
+				continue
+			}
+
+			if err := checkType(valueType, *value); err != nil {
+				return nil, nil, fmt.Errorf("unable to decode %s: %w", field, err)
+			}
+
+			normalized, err := responseUnit(units[field]).ToCanonical(*value, unit)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to normalise %s: %w", field, err)
+			}
+
+			steps[i][param] = normalized
+		}
+	}
+
+	return times, steps, nil
+}
+
+// responseUnit maps the unit labels of Open-Meteo responses that differ from
+// the enums ones.
+func responseUnit(label string) enums.Unit {
+	if label == "mp/h" {
+		return enums.UnitMilesPerHour
+	}
+
+	return enums.Unit(label)
+}
+
+func checkType(valueType enums.ValueType, value float64) error {
+	if valueType == enums.ValueTypeInteger && value != math.Trunc(value) {
+		return fmt.Errorf("expected integer value, got %v", value)
+	}
+
+	return nil
+}
+
+func (m measurements) condition(city weather_service.City, capturedAt time.Time) weather_service.CityWeatherCondition {
+	return weather_service.CityWeatherCondition{
+		City:                    city,
+		CapturedAt:              capturedAt,
+		Temperature:             measurement(m, enums.MonitoringParamTemperature, enums.Celsius),
+		RelativeHumidityPercent: measurement(m, enums.MonitoringParamRelativeHumidity, func(v float64) uint8 { return uint8(v) }),
+		WindSpeed:               measurement(m, enums.MonitoringParamWindSpeed, enums.KilometersPerHour),
+		WeatherCode:             measurement(m, enums.MonitoringParamWeatherCode, func(v float64) enums.WeatherCode { return enums.WeatherCode(v) }),
+		CloudCoverPercent:       measurement(m, enums.MonitoringParamCloudCover, func(v float64) uint8 { return uint8(v) }),
+		Precipitation:           measurement(m, enums.MonitoringParamPrecipitation, enums.Millimeters),
+		Visibility:              measurement(m, enums.MonitoringParamVisibility, enums.Meters),
+	}
+}
+
+func measurement[P comparable, T any](m map[P]float64, param P, convert func(float64) T) *T {
+	value, ok := m[param]
+	if !ok {
+		return nil
+	}
+
+	return ptr.To(convert(value))
+}
+
+// decodeLocations accepts both the single-location object and the array
+// Open-Meteo returns when several coordinates are requested at once.
+func decodeLocations[T any](body []byte) ([]T, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var responses []T
+		if err := json.Unmarshal(trimmed, &responses); err != nil {
+			return nil, err
+		}
+
+		return responses, nil
+	}
+
+	var response T
+	if err := json.Unmarshal(trimmed, &response); err != nil {
+		return nil, err
+	}
+
+	return []T{response}, nil
+}
+
+func (m measurements) hourlyForecast(forecastTime time.Time) weather_service.HourlyForecast {
+	return weather_service.HourlyForecast{
+		Time:                    forecastTime,
+		Temperature:             measurement(m, enums.MonitoringParamTemperature, enums.Celsius),
+		RelativeHumidityPercent: measurement(m, enums.MonitoringParamRelativeHumidity, func(v float64) uint8 { return uint8(v) }),
+		WindSpeed:               measurement(m, enums.MonitoringParamWindSpeed, enums.KilometersPerHour),
+		WeatherCode:             measurement(m, enums.MonitoringParamWeatherCode, func(v float64) enums.WeatherCode { return enums.WeatherCode(v) }),
+		CloudCoverPercent:       measurement(m, enums.MonitoringParamCloudCover, func(v float64) uint8 { return uint8(v) }),
+		Precipitation:           measurement(m, enums.MonitoringParamPrecipitation, enums.Millimeters),
+		Visibility:              measurement(m, enums.MonitoringParamVisibility, enums.Meters),
+	}
+}
+
+func (m dailyMeasurements) dailyForecast(date time.Time) weather_service.DailyForecast {
+	return weather_service.DailyForecast{
+		Date:             date,
+		WeatherCode:      measurement(m, enums.DailyParamWeatherCode, func(v float64) enums.WeatherCode { return enums.WeatherCode(v) }),
+		TemperatureMax:   measurement(m, enums.DailyParamTemperatureMax, enums.Celsius),
+		TemperatureMin:   measurement(m, enums.DailyParamTemperatureMin, enums.Celsius),
+		PrecipitationSum: measurement(m, enums.DailyParamPrecipitationSum, enums.Millimeters),
+		WindSpeedMax:     measurement(m, enums.DailyParamWindSpeedMax, enums.KilometersPerHour),
+	}
+}
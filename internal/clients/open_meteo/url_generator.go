@@ -4,27 +4,95 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
 	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
 )
 
+const (
+	forecastBaseURL = "https://api.open-meteo.com/v1/forecast"
+	archiveBaseURL  = "https://archive-api.open-meteo.com/v1/archive"
+)
+
+// urlGeneratorImpl requests values in the configured unit system. An empty
+// unit leaves the Open-Meteo default in place.
 type urlGeneratorImpl struct {
+	units enums.UnitSystem
 }
 
-func NewURLGenerator() *urlGeneratorImpl {
-	return &urlGeneratorImpl{}
+func NewURLGenerator(units enums.UnitSystem) *urlGeneratorImpl {
+	return &urlGeneratorImpl{
+		units: units,
+	}
+}
+
+func (g *urlGeneratorImpl) GenerateURL(coordinates []weather_service.Coordinates, params weather_service.MonitoringParamsMap) string {
+	lats := make([]string, 0, len(coordinates))
+	longs := make([]string, 0, len(coordinates))
+	for _, c := range coordinates {
+		lats = append(lats, fmt.Sprintf("%.2f", c.Lat))
+		longs = append(longs, fmt.Sprintf("%.2f", c.Long))
+	}
+
+	var (
+		latParam        = fmt.Sprintf("latitude=%s", strings.Join(lats, ","))
+		longParam       = fmt.Sprintf("longitude=%s", strings.Join(longs, ","))
+		currentParamStr = fmt.Sprintf("current=%s", joinSortedValues(params))
+	)
+
+	queryParams := []string{latParam, longParam, currentParamStr}
+	queryParams = append(queryParams, g.unitParams()...)
+	return fmt.Sprintf("%s?%s", forecastBaseURL, strings.Join(queryParams, "&"))
 }
 
-func (g *urlGeneratorImpl) GenerateURL(coordinates weather_service.Coordinates, params weather_service.MonitoringParamsMap) string {
+func (g *urlGeneratorImpl) GenerateForecastURL(coordinates weather_service.Coordinates, params weather_service.ForecastParams) string {
 	var (
-		baseURL       = "https://api.open-meteo.com/v1/forecast"
-		latParam      = fmt.Sprintf("latitude=%.2f", coordinates.Lat)
-		longParam     = fmt.Sprintf("longitude=%.2f", coordinates.Long)
-		currentParams = make([]string, 0, len(params))
+		latParam       = fmt.Sprintf("latitude=%.2f", coordinates.Lat)
+		longParam      = fmt.Sprintf("longitude=%.2f", coordinates.Long)
+		hourlyParamStr = fmt.Sprintf("hourly=%s", joinSortedValues(params.Hourly))
+		dailyParamStr  = fmt.Sprintf("daily=%s", joinSortedValues(params.Daily))
+		daysParamStr   = fmt.Sprintf("forecast_days=%d", params.Days)
 	)
 
-	keys := make([]enums.MonitoringParam, 0, len(params))
+	queryParams := []string{latParam, longParam, hourlyParamStr, dailyParamStr, daysParamStr}
+	queryParams = append(queryParams, g.unitParams()...)
+	return fmt.Sprintf("%s?%s", forecastBaseURL, strings.Join(queryParams, "&"))
+}
+
+func (g *urlGeneratorImpl) GenerateArchiveURL(coordinates weather_service.Coordinates, params weather_service.MonitoringParamsMap, from, to time.Time) string {
+	var (
+		latParam       = fmt.Sprintf("latitude=%.2f", coordinates.Lat)
+		longParam      = fmt.Sprintf("longitude=%.2f", coordinates.Long)
+		startParamStr  = fmt.Sprintf("start_date=%s", from.Format(time.DateOnly))
+		endParamStr    = fmt.Sprintf("end_date=%s", to.Format(time.DateOnly))
+		hourlyParamStr = fmt.Sprintf("hourly=%s", joinSortedValues(params))
+	)
+
+	queryParams := []string{latParam, longParam, startParamStr, endParamStr, hourlyParamStr}
+	queryParams = append(queryParams, g.unitParams()...)
+	return fmt.Sprintf("%s?%s", archiveBaseURL, strings.Join(queryParams, "&"))
+}
+
+func (g *urlGeneratorImpl) unitParams() []string {
+	var params []string
+	if g.units.Temperature != "" {
+		params = append(params, fmt.Sprintf("temperature_unit=%s", g.units.Temperature))
+	}
+
+	if g.units.WindSpeed != "" {
+		params = append(params, fmt.Sprintf("wind_speed_unit=%s", g.units.WindSpeed))
+	}
+
+	if g.units.Precipitation != "" {
+		params = append(params, fmt.Sprintf("precipitation_unit=%s", g.units.Precipitation))
+	}
+
+	return params
+}
+
+func joinSortedValues[K ~string](params map[K]string) string {
+	keys := make([]K, 0, len(params))
 	for key := range params {
 		keys = append(keys, key)
 	}
@@ -33,11 +101,10 @@ func (g *urlGeneratorImpl) GenerateURL(coordinates weather_service.Coordinates,
 		return string(keys[i]) < string(keys[j])
 	})
 
+	values := make([]string, 0, len(params))
 	for _, key := range keys {
-		currentParams = append(currentParams, params[key])
+		values = append(values, params[key])
 	}
 
-	currentParamStr := fmt.Sprintf("current=%s", strings.Join(currentParams, ","))
-	queryParams := []string{latParam, longParam, currentParamStr}
-	return fmt.Sprintf("%s?%s", baseURL, strings.Join(queryParams, "&"))
+	return strings.Join(values, ",")
 }
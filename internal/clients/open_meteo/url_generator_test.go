@@ -2,6 +2,7 @@ package open_meteo_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/meteogo/weather-collector-service/internal/clients/open_meteo"
 	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
@@ -13,15 +14,18 @@ func TestUrlGenerator(t *testing.T) {
 
 	tests := []struct {
 		name        string
-		coordinates weather_service.Coordinates
+		coordinates []weather_service.Coordinates
 		params      weather_service.MonitoringParamsMap
+		units       enums.UnitSystem
 		expectedURL string
 	}{
 		{
 			name: "happy path",
-			coordinates: weather_service.Coordinates{
-				Lat:  41.19,
-				Long: 4.70,
+			coordinates: []weather_service.Coordinates{
+				{
+					Lat:  41.19,
+					Long: 4.70,
+				},
 			},
 			params: weather_service.MonitoringParamsMap{
 				enums.MonitoringParamTemperature:      "temperature_2m",
@@ -36,22 +40,61 @@ func TestUrlGenerator(t *testing.T) {
 		},
 		{
 			name: "one param",
-			coordinates: weather_service.Coordinates{
-				Lat:  41.19,
-				Long: 4.70,
+			coordinates: []weather_service.Coordinates{
+				{
+					Lat:  41.19,
+					Long: 4.70,
+				},
 			},
 			params: weather_service.MonitoringParamsMap{
 				enums.MonitoringParamTemperature: "temperature_2m",
 			},
 			expectedURL: "https://api.open-meteo.com/v1/forecast?latitude=41.19&longitude=4.70&current=temperature_2m",
 		},
+		{
+			name: "multiple locations",
+			coordinates: []weather_service.Coordinates{
+				{
+					Lat:  52.52,
+					Long: 13.41,
+				},
+				{
+					Lat:  48.86,
+					Long: 2.35,
+				},
+				{
+					Lat:  51.51,
+					Long: -0.13,
+				},
+			},
+			params: weather_service.MonitoringParamsMap{
+				enums.MonitoringParamTemperature: "temperature_2m",
+			},
+			expectedURL: "https://api.open-meteo.com/v1/forecast?latitude=52.52,48.86,51.51&longitude=13.41,2.35,-0.13&current=temperature_2m",
+		},
+		{
+			name: "imperial units",
+			coordinates: []weather_service.Coordinates{
+				{
+					Lat:  40.71,
+					Long: -74.01,
+				},
+			},
+			params: weather_service.MonitoringParamsMap{
+				enums.MonitoringParamTemperature:   "temperature_2m",
+				enums.MonitoringParamWindSpeed:     "wind_speed_10m",
+				enums.MonitoringParamPrecipitation: "precipitation",
+			},
+			units:       enums.UnitSystemImperial,
+			expectedURL: "https://api.open-meteo.com/v1/forecast?latitude=40.71&longitude=-74.01&current=precipitation,temperature_2m,wind_speed_10m&temperature_unit=fahrenheit&wind_speed_unit=mph&precipitation_unit=inch",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			generator := open_meteo.NewURLGenerator()
+			generator := open_meteo.NewURLGenerator(tt.units)
 			url := generator.GenerateURL(tt.coordinates, tt.params)
 			if url != tt.expectedURL {
 				t.Fail()
@@ -59,3 +102,67 @@ func TestUrlGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestForecastUrlGenerator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		coordinates weather_service.Coordinates
+		params      weather_service.ForecastParams
+		expectedURL string
+	}{
+		{
+			name: "happy path",
+			coordinates: weather_service.Coordinates{
+				Lat:  41.19,
+				Long: 4.70,
+			},
+			params: weather_service.ForecastParams{
+				Hourly: weather_service.MonitoringParamsMap{
+					enums.MonitoringParamTemperature:   "temperature_2m",
+					enums.MonitoringParamPrecipitation: "precipitation",
+				},
+				Daily: weather_service.DailyParamsMap{
+					enums.DailyParamTemperatureMax: "temperature_2m_max",
+					enums.DailyParamTemperatureMin: "temperature_2m_min",
+					enums.DailyParamWeatherCode:    "weather_code",
+				},
+				Days: 7,
+			},
+			expectedURL: "https://api.open-meteo.com/v1/forecast?latitude=41.19&longitude=4.70&hourly=precipitation,temperature_2m&daily=temperature_2m_max,temperature_2m_min,weather_code&forecast_days=7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			generator := open_meteo.NewURLGenerator(enums.UnitSystem{})
+			url := generator.GenerateForecastURL(tt.coordinates, tt.params)
+			if url != tt.expectedURL {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestArchiveUrlGenerator(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)
+	)
+
+	generator := open_meteo.NewURLGenerator(enums.UnitSystem{})
+	url := generator.GenerateArchiveURL(weather_service.Coordinates{Lat: 52.52, Long: 13.41}, weather_service.MonitoringParamsMap{
+		enums.MonitoringParamTemperature:   "temperature_2m",
+		enums.MonitoringParamPrecipitation: "precipitation",
+	}, from, to)
+
+	expectedURL := "https://archive-api.open-meteo.com/v1/archive?latitude=52.52&longitude=13.41&start_date=2025-01-01&end_date=2025-01-31&hourly=precipitation,temperature_2m"
+	if url != expectedURL {
+		t.Fail()
+	}
+}
@@ -0,0 +1,35 @@
+package registry
+
+import (
+	"sync"
+
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+type Registry struct {
+	providers map[enums.Provider]weather_service.WeatherProvider
+
+	mu sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[enums.Provider]weather_service.WeatherProvider),
+	}
+}
+
+func (r *Registry) Register(name enums.Provider, provider weather_service.WeatherProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.providers[name] = provider
+}
+
+func (r *Registry) Provider(name enums.Provider) (weather_service.WeatherProvider, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	provider, ok := r.providers[name]
+	return provider, ok
+}
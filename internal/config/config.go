@@ -5,10 +5,63 @@ import "github.com/meteogo/config/pkg/config"
 const (
 	WeatherCollectorCronDuration = config.Key("weather_collector_cron_duration")
 	WeatherSenderCronDuration    = config.Key("weather_sender_cron_duration")
+	WeatherForecastCronDuration  = config.Key("weather_forecast_cron_duration")
+	WeatherSnapshotCronDuration  = config.Key("weather_snapshot_cron_duration")
+	CityRefreshCronDuration      = config.Key("city_refresh_cron_duration")
 	CollectorWorkerPoolSize      = config.Key("collector_worker_pool_size")
+	CollectorBatchSize           = config.Key("collector_batch_size")
+	OutboxBatchSize              = config.Key("outbox_batch_size")
+	OutboxMaxAttempts            = config.Key("outbox_max_attempts")
 
 	ReportedCities   = config.Key("reported_cities")
 	MonitoringParams = config.Key("monitoring_params")
+	WeatherProviders = config.Key("weather_providers")
+
+	MetNorwayUserAgent      = config.Key("met_norway_user_agent")
+	MetNorwayRequestTimeout = config.Key("met_norway_request_timeout")
+
+	OpenMeteoRequestTimeout = config.Key("open_meteo_request_timeout")
+	OpenMeteoMaxRetries     = config.Key("open_meteo_max_retries")
+	OpenMeteoRetryBaseDelay = config.Key("open_meteo_retry_base_delay")
+	OpenMeteoRetryMaxDelay  = config.Key("open_meteo_retry_max_delay")
+	OpenMeteoUnitSystem     = config.Key("open_meteo_unit_system")
+
+	KafkaWeatherUnitSystem = config.Key("kafka_weather_unit_system")
+	KafkaWeatherEncoding   = config.Key("kafka_weather_encoding")
+	KafkaWeatherMode       = config.Key("kafka_weather_publish_mode")
+
+	KafkaTLSEnabled    = config.Key("kafka_tls_enabled")
+	KafkaSASLMechanism = config.Key("kafka_sasl_mechanism")
+	KafkaCompression   = config.Key("kafka_compression")
+	KafkaBatchSize     = config.Key("kafka_batch_size")
+	KafkaBatchTimeout  = config.Key("kafka_batch_timeout")
+	KafkaRequiredAcks  = config.Key("kafka_required_acks")
+	KafkaMaxAttempts   = config.Key("kafka_max_attempts")
+
+	KafkaTopicAutoCreate               = config.Key("kafka_topic_auto_create")
+	KafkaWeatherTopicPartitions        = config.Key("kafka_weather_topic_partitions")
+	KafkaWeatherTopicReplicationFactor = config.Key("kafka_weather_topic_replication_factor")
+	KafkaWeatherTopicCleanupPolicy     = config.Key("kafka_weather_topic_cleanup_policy")
+
+	ForecastDays        = config.Key("forecast_days")
+	ForecastDailyParams = config.Key("forecast_daily_params")
+
+	ArchiveMonitoringParams = config.Key("archive_monitoring_params")
+
+	WeatherAlertRules = config.Key("weather_alert_rules")
+
+	HTTPAPIAddress  = config.Key("http_api_address")
+	AdminAPIAddress = config.Key("admin_api_address")
+
+	GRPCAPIAddress            = config.Key("grpc_api_address")
+	GRPCAPISubscriptionBuffer = config.Key("grpc_api_subscription_buffer")
+
+	OpsHTTPAddress       = config.Key("ops_http_address")
+	OpsCheckTimeout      = config.Key("ops_check_timeout")
+	OpsCollectDataMaxAge = config.Key("ops_collect_data_max_age")
+	OpsSendDataMaxAge    = config.Key("ops_send_data_max_age")
+
+	ConfigReloadInterval = config.Key("config_reload_interval")
 
 	ApplicationName = config.Key("application_name")
 	Env             = config.Key("env")
@@ -21,9 +74,15 @@ const (
 	PostgresPort         = config.Secret("POSTGRES_PORT")
 	PostgresDatabaseName = config.Secret("POSTGRES_DATABASE_NAME")
 
-	KafkaHost             = config.Secret("KAFKA_HOST")
-	KafkaPort             = config.Secret("KAFKA_PORT")
-	KafkaWeatherTopicName = config.Secret("KAFKA_WEATHER_TOPIC_NAME")
+	KafkaBrokers                    = config.Secret("KAFKA_BROKERS")
+	KafkaTLSCAFile                  = config.Secret("KAFKA_TLS_CA_FILE")
+	KafkaTLSCertFile                = config.Secret("KAFKA_TLS_CERT_FILE")
+	KafkaTLSKeyFile                 = config.Secret("KAFKA_TLS_KEY_FILE")
+	KafkaSASLUsername               = config.Secret("KAFKA_SASL_USERNAME")
+	KafkaSASLPassword               = config.Secret("KAFKA_SASL_PASSWORD")
+	KafkaWeatherTopicName           = config.Secret("KAFKA_WEATHER_TOPIC_NAME")
+	KafkaWeatherDeadLetterTopicName = config.Secret("KAFKA_WEATHER_DEAD_LETTER_TOPIC_NAME")
+	KafkaWeatherAlertsTopicName     = config.Secret("KAFKA_WEATHER_ALERTS_TOPIC_NAME")
 
 	JaegerHost = config.Secret("JAEGER_HOST")
 	JaegerPort = config.Secret("JAEGER_PORT")
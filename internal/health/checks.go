@@ -0,0 +1,120 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusOK    = Status("ok")
+	StatusError = Status("error")
+)
+
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc adapts a plain function, e.g. a database ping, to a Checker.
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Checks are the checkers of an endpoint, keyed by the component they report.
+type Checks map[string]Checker
+
+type (
+	Component struct {
+		Status     Status `json:"status"`
+		Error      string `json:"error,omitempty"`
+		DurationMs int64  `json:"durationMs"`
+	}
+
+	Report struct {
+		Status     Status               `json:"status"`
+		Components map[string]Component `json:"components"`
+	}
+)
+
+// Run executes the checks concurrently, each one bounded by timeout. The
+// report is ok only when every component is.
+func (c Checks) Run(ctx context.Context, timeout time.Duration) Report {
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		report = Report{
+			Status:     StatusOK,
+			Components: make(map[string]Component, len(c)),
+		}
+	)
+
+	for name, checker := range c {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := checker.Check(checkCtx)
+
+			component := Component{
+				Status:     StatusOK,
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				component.Status = StatusError
+				component.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			report.Components[name] = component
+			if err != nil {
+				report.Status = StatusError
+			}
+		}()
+	}
+
+	wg.Wait()
+	return report
+}
+
+// Heartbeat fails when the job it tracks has not succeeded for longer than
+// maxAge. Until the first success the age is counted from its creation, so a
+// freshly started service is not reported unhealthy.
+type Heartbeat struct {
+	maxAge time.Duration
+	last   atomic.Int64
+}
+
+func NewHeartbeat(maxAge time.Duration) *Heartbeat {
+	h := &Heartbeat{
+		maxAge: maxAge,
+	}
+	h.last.Store(time.Now().UnixNano())
+
+	return h
+}
+
+// Beat records a successful run.
+func (h *Heartbeat) Beat() {
+	h.last.Store(time.Now().UnixNano())
+}
+
+func (h *Heartbeat) Check(ctx context.Context) error {
+	last := time.Unix(0, h.last.Load())
+	if age := time.Since(last); age > h.maxAge {
+		return fmt.Errorf("last success at %s, %s ago, exceeds %s", last.UTC().Format(time.RFC3339), age.Truncate(time.Second), h.maxAge)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	var (
+		ok   = health.CheckerFunc(func(context.Context) error { return nil })
+		fail = health.CheckerFunc(func(context.Context) error { return errors.New("connection refused") })
+		slow = health.CheckerFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	)
+
+	tests := []struct {
+		name           string
+		checks         health.Checks
+		wantStatusCode int
+		wantReport     health.Report
+	}{
+		{
+			name:           "no checks",
+			checks:         health.Checks{},
+			wantStatusCode: http.StatusOK,
+			wantReport: health.Report{
+				Status:     health.StatusOK,
+				Components: map[string]health.Component{},
+			},
+		},
+		{
+			name:           "all checks pass",
+			checks:         health.Checks{"postgres": ok, "kafka": ok},
+			wantStatusCode: http.StatusOK,
+			wantReport: health.Report{
+				Status: health.StatusOK,
+				Components: map[string]health.Component{
+					"postgres": {Status: health.StatusOK},
+					"kafka":    {Status: health.StatusOK},
+				},
+			},
+		},
+		{
+			name:           "failing and timed out checks",
+			checks:         health.Checks{"postgres": ok, "kafka": fail, "collect_data": slow},
+			wantStatusCode: http.StatusServiceUnavailable,
+			wantReport: health.Report{
+				Status: health.StatusError,
+				Components: map[string]health.Component{
+					"postgres":     {Status: health.StatusOK},
+					"kafka":        {Status: health.StatusError, Error: "connection refused"},
+					"collect_data": {Status: health.StatusError, Error: context.DeadlineExceeded.Error()},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			health.Handler(tt.checks, 10*time.Millisecond).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tt.wantStatusCode, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var report health.Report
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+			for name, component := range report.Components {
+				component.DurationMs = 0
+				report.Components[name] = component
+			}
+
+			assert.Equal(t, tt.wantReport, report)
+		})
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	fresh := health.NewHeartbeat(time.Hour)
+	fresh.Beat()
+	assert.NoError(t, fresh.Check(context.Background()))
+
+	stale := health.NewHeartbeat(50 * time.Millisecond)
+	time.Sleep(60 * time.Millisecond)
+	assert.Error(t, stale.Check(context.Background()))
+
+	stale.Beat()
+	assert.NoError(t, stale.Check(context.Background()))
+}
@@ -0,0 +1,66 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+)
+
+// Server is the ops HTTP server. /livez and /readyz answer 200 when all of
+// their checks pass and 503 otherwise, with the status of every component
+// in the body.
+type Server struct {
+	server *http.Server
+}
+
+func NewServer(addr string, timeout time.Duration, live, ready Checks) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /livez", Handler(live, timeout))
+	mux.Handle("GET /readyz", Handler(ready, timeout))
+
+	return &Server{
+		server: &http.Server{
+			Addr:              addr,
+			Handler:           mux,
+			ReadHeaderTimeout: timeout,
+		},
+	}
+}
+
+func Handler(checks Checks, timeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := checks.Run(r.Context(), timeout)
+
+		status := http.StatusOK
+		if report.Status != StatusOK {
+			status = http.StatusServiceUnavailable
+			logger.Warn(r.Context(), "health check failed", slog.String("path", r.URL.Path), slog.Any("components", report.Components))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			logger.Error(r.Context(), "unable to write health report", slog.Any("error", err))
+		}
+	})
+}
+
+func (s *Server) Start(ctx context.Context) {
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(ctx, "ops server stopped", slog.Any("error", err))
+		}
+	}()
+
+	logger.Info(ctx, fmt.Sprintf("[%T.Start] ops server started", s), slog.String("addr", s.server.Addr))
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
@@ -0,0 +1,211 @@
+package connection
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+)
+
+type SASLMechanism string
+
+const (
+	SASLMechanismNone        = SASLMechanism("")
+	SASLMechanismPlain       = SASLMechanism("plain")
+	SASLMechanismSCRAMSHA512 = SASLMechanism("scram-sha-512")
+)
+
+// TLS is used when Enabled. Without CAFile the system roots are trusted,
+// CertFile and KeyFile are only needed by clusters requiring client
+// certificates.
+type TLS struct {
+	Enabled  bool
+	CAFile   string
+	CertFile string
+	KeyFile  string
+}
+
+type SASL struct {
+	Mechanism SASLMechanism
+	Username  string
+	Password  string
+}
+
+// Config describes how to reach the cluster, it is shared by every writer.
+type Config struct {
+	Brokers []string
+	TLS     TLS
+	SASL    SASL
+}
+
+// Writer holds the tuning of a kafka.Writer, zero values keep the defaults of
+// kafka-go.
+type Writer struct {
+	Compression  kafka.Compression
+	BatchSize    int
+	BatchTimeout time.Duration
+	RequiredAcks kafka.RequiredAcks
+	MaxAttempts  int
+}
+
+// ParseBrokers accepts a comma separated list of host:port pairs.
+func ParseBrokers(value string) ([]string, error) {
+	brokers := make([]string, 0)
+	for _, broker := range strings.Split(value, ",") {
+		broker = strings.TrimSpace(broker)
+		if broker == "" {
+			continue
+		}
+
+		if _, _, err := net.SplitHostPort(broker); err != nil {
+			return nil, fmt.Errorf("invalid kafka broker %q: %w", broker, err)
+		}
+
+		brokers = append(brokers, broker)
+	}
+
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka broker list can not be empty")
+	}
+
+	return brokers, nil
+}
+
+func ParseSASLMechanism(value string) (SASLMechanism, error) {
+	switch mechanism := SASLMechanism(strings.ToLower(value)); mechanism {
+	case SASLMechanismNone, SASLMechanismPlain, SASLMechanismSCRAMSHA512:
+		return mechanism, nil
+	default:
+		return "", fmt.Errorf("unknown kafka sasl mechanism %q", value)
+	}
+}
+
+// ParseCompression accepts none, gzip, snappy, lz4 or zstd.
+func ParseCompression(value string) (kafka.Compression, error) {
+	var compression kafka.Compression
+	if err := compression.UnmarshalText([]byte(value)); err != nil {
+		return 0, err
+	}
+
+	return compression, nil
+}
+
+// ParseRequiredAcks accepts none, one or all.
+func ParseRequiredAcks(value string) (kafka.RequiredAcks, error) {
+	var acks kafka.RequiredAcks
+	if err := acks.UnmarshalText([]byte(value)); err != nil {
+		return 0, err
+	}
+
+	return acks, nil
+}
+
+func (c Config) NewWriter(topic string, balancer kafka.Balancer, tuning Writer) (*kafka.Writer, error) {
+	transport, err := c.transport()
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafka.Writer{
+		Addr:         kafka.TCP(c.Brokers...),
+		Topic:        topic,
+		Balancer:     balancer,
+		Compression:  tuning.Compression,
+		BatchSize:    tuning.BatchSize,
+		BatchTimeout: tuning.BatchTimeout,
+		RequiredAcks: tuning.RequiredAcks,
+		MaxAttempts:  tuning.MaxAttempts,
+		Transport:    transport,
+	}, nil
+}
+
+// NewClient returns a client for admin and metadata requests.
+func (c Config) NewClient(timeout time.Duration) (*kafka.Client, error) {
+	transport, err := c.transport()
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafka.Client{
+		Addr:      kafka.TCP(c.Brokers...),
+		Timeout:   timeout,
+		Transport: transport,
+	}, nil
+}
+
+func (c Config) transport() (*kafka.Transport, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	mechanism, err := c.saslMechanism()
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafka.Transport{
+		TLS:  tlsConfig,
+		SASL: mechanism,
+	}, nil
+}
+
+func (c Config) tlsConfig() (*tls.Config, error) {
+	if !c.TLS.Enabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if c.TLS.CAFile != "" {
+		pem, err := os.ReadFile(c.TLS.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read kafka ca file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in kafka ca file %s", c.TLS.CAFile)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.TLS.CertFile != "" || c.TLS.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(c.TLS.CertFile, c.TLS.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load kafka client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+func (c Config) saslMechanism() (sasl.Mechanism, error) {
+	switch c.SASL.Mechanism {
+	case SASLMechanismNone:
+		return nil, nil
+	case SASLMechanismPlain:
+		return plain.Mechanism{
+			Username: c.SASL.Username,
+			Password: c.SASL.Password,
+		}, nil
+	case SASLMechanismSCRAMSHA512:
+		return scram.Mechanism(scram.SHA512, c.SASL.Username, c.SASL.Password)
+	default:
+		return nil, fmt.Errorf("unknown kafka sasl mechanism %q", c.SASL.Mechanism)
+	}
+}
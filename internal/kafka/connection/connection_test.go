@@ -0,0 +1,139 @@
+package connection_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/kafka/connection"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBrokers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       string
+		want        []string
+		wantErrFunc assert.ErrorAssertionFunc
+	}{
+		{
+			name:        "single broker",
+			value:       "localhost:9092",
+			want:        []string{"localhost:9092"},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:        "several brokers with spaces",
+			value:       "kafka-1:9093, kafka-2:9093,,kafka-3:9093 ",
+			want:        []string{"kafka-1:9093", "kafka-2:9093", "kafka-3:9093"},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:        "missing port",
+			value:       "kafka-1",
+			wantErrFunc: assert.Error,
+		},
+		{
+			name:        "empty list",
+			value:       " , ",
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := connection.ParseBrokers(tt.value)
+			if !tt.wantErrFunc(t, err) {
+				t.Fail()
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseWriterSettings(t *testing.T) {
+	t.Parallel()
+
+	compression, err := connection.ParseCompression("zstd")
+	require.NoError(t, err)
+	assert.Equal(t, kafka.Zstd, compression)
+
+	compression, err = connection.ParseCompression("none")
+	require.NoError(t, err)
+	assert.Equal(t, kafka.Compression(0), compression)
+
+	_, err = connection.ParseCompression("brotli")
+	assert.Error(t, err)
+
+	acks, err := connection.ParseRequiredAcks("one")
+	require.NoError(t, err)
+	assert.Equal(t, kafka.RequireOne, acks)
+
+	_, err = connection.ParseRequiredAcks("some")
+	assert.Error(t, err)
+
+	mechanism, err := connection.ParseSASLMechanism("SCRAM-SHA-512")
+	require.NoError(t, err)
+	assert.Equal(t, connection.SASLMechanismSCRAMSHA512, mechanism)
+
+	_, err = connection.ParseSASLMechanism("gssapi")
+	assert.Error(t, err)
+}
+
+func TestConfig_NewWriter(t *testing.T) {
+	t.Parallel()
+
+	tuning := connection.Writer{
+		Compression:  kafka.Lz4,
+		BatchSize:    50,
+		BatchTimeout: 200 * time.Millisecond,
+		RequiredAcks: kafka.RequireAll,
+		MaxAttempts:  5,
+	}
+
+	t.Run("plaintext with sasl", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := connection.Config{
+			Brokers: []string{"kafka-1:9092", "kafka-2:9092"},
+			SASL: connection.SASL{
+				Mechanism: connection.SASLMechanismSCRAMSHA512,
+				Username:  "collector",
+				Password:  "secret",
+			},
+		}
+
+		writer, err := cfg.NewWriter("weather", &kafka.Hash{}, tuning)
+		require.NoError(t, err)
+
+		assert.Equal(t, "kafka-1:9092,kafka-2:9092", writer.Addr.String())
+		assert.Equal(t, kafka.Lz4, writer.Compression)
+		assert.Equal(t, 50, writer.BatchSize)
+		assert.Equal(t, 5, writer.MaxAttempts)
+
+		transport, ok := writer.Transport.(*kafka.Transport)
+		require.True(t, ok)
+		assert.Nil(t, transport.TLS)
+		assert.Equal(t, "SCRAM-SHA-512", transport.SASL.Name())
+	})
+
+	t.Run("missing ca file", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := connection.Config{
+			Brokers: []string{"kafka-1:9093"},
+			TLS: connection.TLS{
+				Enabled: true,
+				CAFile:  "testdata/missing.pem",
+			},
+		}
+
+		_, err := cfg.NewWriter("weather", &kafka.Hash{}, tuning)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,73 @@
+package encoder
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ContentTypeHeader is the Kafka header naming the encoding of the value.
+const ContentTypeHeader = "content-type"
+
+type Format string
+
+const (
+	FormatProto     = Format("proto")
+	FormatProtoJSON = Format("protojson")
+	FormatJSON      = Format("json")
+)
+
+type Encoder interface {
+	Encode(msg proto.Message) ([]byte, error)
+	ContentType() string
+}
+
+func New(format Format) (Encoder, error) {
+	switch format {
+	case FormatProto:
+		return protoEncoder{}, nil
+	case FormatProtoJSON:
+		return protoJSONEncoder{}, nil
+	case FormatJSON:
+		return jsonEncoder{}, nil
+	default:
+		return nil, fmt.Errorf("unknown encoder format %q", format)
+	}
+}
+
+// protoEncoder writes the binary wire format of the generated pkg/events types.
+type protoEncoder struct{}
+
+func (protoEncoder) Encode(msg proto.Message) ([]byte, error) {
+	return proto.Marshal(msg)
+}
+
+func (protoEncoder) ContentType() string {
+	return "application/x-protobuf"
+}
+
+// protoJSONEncoder follows the canonical proto3 JSON mapping: enums by name,
+// timestamps as RFC 3339 strings.
+type protoJSONEncoder struct{}
+
+func (protoJSONEncoder) Encode(msg proto.Message) ([]byte, error) {
+	return protojson.Marshal(msg)
+}
+
+func (protoJSONEncoder) ContentType() string {
+	return "application/x-protobuf+json"
+}
+
+// jsonEncoder runs encoding/json over the generated structs. It is kept for
+// consumers of the original payloads.
+type jsonEncoder struct{}
+
+func (jsonEncoder) Encode(msg proto.Message) ([]byte, error) {
+	return json.Marshal(msg)
+}
+
+func (jsonEncoder) ContentType() string {
+	return "application/json"
+}
@@ -0,0 +1,89 @@
+package encoder_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/kafka/encoder"
+	weather_collector_events "github.com/meteogo/weather-collector-service/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEncoder(t *testing.T) {
+	t.Parallel()
+
+	temperature := 12.5
+	msg := &weather_collector_events.CityWeatherConditions{
+		Conditions: []*weather_collector_events.CityWeatherCondition{
+			{
+				City:        &weather_collector_events.City{Name: "Berlin"},
+				CapturedAt:  timestamppb.New(time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC)),
+				Temperature: &temperature,
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		format          encoder.Format
+		wantContentType string
+		decode          func(data []byte, msg *weather_collector_events.CityWeatherConditions) error
+	}{
+		{
+			name:            "binary proto",
+			format:          encoder.FormatProto,
+			wantContentType: "application/x-protobuf",
+			decode: func(data []byte, msg *weather_collector_events.CityWeatherConditions) error {
+				return proto.Unmarshal(data, msg)
+			},
+		},
+		{
+			name:            "protojson",
+			format:          encoder.FormatProtoJSON,
+			wantContentType: "application/x-protobuf+json",
+			decode: func(data []byte, msg *weather_collector_events.CityWeatherConditions) error {
+				return protojson.Unmarshal(data, msg)
+			},
+		},
+		{
+			name:            "plain json",
+			format:          encoder.FormatJSON,
+			wantContentType: "application/json",
+			decode: func(data []byte, msg *weather_collector_events.CityWeatherConditions) error {
+				return json.Unmarshal(data, msg)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			enc, err := encoder.New(tt.format)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			data, err := enc.Encode(msg)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var decoded weather_collector_events.CityWeatherConditions
+			assert.NoError(t, tt.decode(data, &decoded))
+			assert.True(t, proto.Equal(msg, &decoded))
+			assert.Equal(t, tt.wantContentType, enc.ContentType())
+		})
+	}
+}
+
+func TestEncoder_UnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := encoder.New("avro")
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package weather_publisher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/services/alert_service"
+	weather_collector_events "github.com/meteogo/weather-collector-service/pkg/events"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	alertSeverities = map[alert_service.Severity]weather_collector_events.AlertSeverity{
+		alert_service.SeverityInfo:     weather_collector_events.AlertSeverity_ALERT_SEVERITY_INFO,
+		alert_service.SeverityWarning:  weather_collector_events.AlertSeverity_ALERT_SEVERITY_WARNING,
+		alert_service.SeverityCritical: weather_collector_events.AlertSeverity_ALERT_SEVERITY_CRITICAL,
+	}
+
+	alertStates = map[alert_service.State]weather_collector_events.AlertState{
+		alert_service.StateRaised:  weather_collector_events.AlertState_ALERT_STATE_RAISED,
+		alert_service.StateCleared: weather_collector_events.AlertState_ALERT_STATE_CLEARED,
+	}
+)
+
+// PublishAlerts sends every alert as its own message keyed by the city, so
+// the raised and cleared events of a city stay in order.
+func (wp *WeatherPublisher) PublishAlerts(ctx context.Context, alerts alert_service.Alerts) error {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.PublishAlerts]", wp))
+	defer span.End()
+
+	if len(alerts) == 0 {
+		logger.Warn(ctx, "alerts len is zero, skipping publishing")
+		return nil
+	}
+
+	out := make([]*weather_collector_events.WeatherAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		out = append(out, mapAlert(alert, wp.units))
+	}
+
+	out, err := rejectInvalid(spanCtx, wp, out, func(a *weather_collector_events.WeatherAlert) string {
+		return CityKey(a.GetCity().GetId())
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(out) == 0 {
+		logger.Warn(ctx, "no valid alerts left, skipping publishing")
+		return nil
+	}
+
+	messages := make([]kafka.Message, 0, len(out))
+	for _, alert := range out {
+		msg, err := wp.message(spanCtx, CityKey(alert.GetCity().GetId()), alert)
+		if err != nil {
+			logger.Error(ctx, "failed to encode alert to kafka message", slog.Any("error", err))
+			return err
+		}
+
+		messages = append(messages, msg)
+	}
+
+	span.SetAttributes(semconv.MessagingSystemKafka, semconv.MessagingBatchMessageCount(len(messages)))
+	if err := wp.alertWriter.WriteMessages(spanCtx, messages...); err != nil {
+		logger.Error(ctx, "failed to write alerts to kafka", slog.Any("error", err.Error()))
+		return err
+	}
+
+	logger.Info(ctx, "weather alerts successfully sent to kafka")
+	return nil
+}
+
+func mapAlert(a alert_service.Alert, units enums.UnitSystem) *weather_collector_events.WeatherAlert {
+	condition := mapCondition(a.Condition, units)
+
+	return &weather_collector_events.WeatherAlert{
+		Rule:        a.Rule.Name,
+		Description: a.Rule.Description,
+		Severity:    alertSeverities[a.Rule.Severity],
+		State:       alertStates[a.State],
+		City:        condition.GetCity(),
+		ChangedAt:   timestamppb.New(a.Condition.CapturedAt.UTC()),
+		Condition:   condition,
+	}
+}
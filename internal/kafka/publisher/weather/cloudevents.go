@@ -0,0 +1,96 @@
+package weather_publisher
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	weather_collector_events "github.com/meteogo/weather-collector-service/pkg/events"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Headers of the CloudEvents Kafka protocol binding in binary content mode.
+// The data content type travels in the content-type header set by the
+// encoder, the payload itself is left as is.
+const (
+	CloudEventsIDHeader           = "ce_id"
+	CloudEventsSourceHeader       = "ce_source"
+	CloudEventsTypeHeader         = "ce_type"
+	CloudEventsTimeHeader         = "ce_time"
+	CloudEventsSpecVersionHeader  = "ce_specversion"
+	CloudEventsEnvHeader          = "ce_env"
+	CloudEventsPartitionKeyHeader = "ce_partitionkey"
+
+	cloudEventsSpecVersion = "1.0"
+)
+
+// Source describes the producer of the events. Application becomes the
+// CloudEvents source, Env is sent as the env extension attribute.
+type Source struct {
+	Application string
+	Env         string
+}
+
+// cloudEventHeaders returns the attributes of a single event. The type is the
+// full name of the proto message carried in the payload, the partition key
+// extension repeats the message key.
+//
+// The id is derived from the type, the key and the content of the event, so
+// an outbox entry published again after a failed attempt keeps its id and
+// consumers can drop the duplicate. The time is when the event happened, the
+// capture time of the readings in it, not when it was sent.
+func (s Source) cloudEventHeaders(key string, event proto.Message) ([]kafka.Header, error) {
+	content, err := proto.MarshalOptions{Deterministic: true}.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := string(proto.MessageName(event))
+	namespace := uuid.NewSHA1(uuid.NameSpaceURL, []byte(s.Application))
+	id := uuid.NewSHA1(namespace, append([]byte(eventType+"\x00"+key+"\x00"), content...))
+
+	return []kafka.Header{
+		{Key: CloudEventsSpecVersionHeader, Value: []byte(cloudEventsSpecVersion)},
+		{Key: CloudEventsIDHeader, Value: []byte(id.String())},
+		{Key: CloudEventsSourceHeader, Value: []byte(s.Application)},
+		{Key: CloudEventsTypeHeader, Value: []byte(eventType)},
+		{Key: CloudEventsTimeHeader, Value: []byte(eventTime(event).UTC().Format(time.RFC3339Nano))},
+		{Key: CloudEventsEnvHeader, Value: []byte(s.Env)},
+		{Key: CloudEventsPartitionKeyHeader, Value: []byte(key)},
+	}, nil
+}
+
+// eventTime is the capture time of the readings in the event, the latest one
+// for a batch. Events without one fall back to the current time.
+func eventTime(event proto.Message) time.Time {
+	var at time.Time
+	switch event := event.(type) {
+	case *weather_collector_events.WeatherAlert:
+		at = event.GetChangedAt().AsTime()
+	case *weather_collector_events.CityWeatherConditions:
+		for _, condition := range event.GetConditions() {
+			at = latest(at, condition.GetCapturedAt())
+		}
+	case *weather_collector_events.CityWeatherForecasts:
+		for _, forecast := range event.GetForecasts() {
+			at = latest(at, forecast.GetCapturedAt())
+		}
+	case interface{ GetCapturedAt() *timestamppb.Timestamp }:
+		at = event.GetCapturedAt().AsTime()
+	}
+
+	if at.IsZero() || at.Equal(time.Unix(0, 0)) {
+		return time.Now()
+	}
+
+	return at
+}
+
+func latest(at time.Time, ts *timestamppb.Timestamp) time.Time {
+	if ts != nil && ts.AsTime().After(at) {
+		return ts.AsTime()
+	}
+
+	return at
+}
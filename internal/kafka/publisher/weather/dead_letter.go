@@ -0,0 +1,78 @@
+package weather_publisher
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// Headers set on dead-letter messages. Every violation is sent as its own
+// reason header.
+const (
+	DeadLetterEventHeader  = "dead-letter-event"
+	DeadLetterReasonHeader = "dead-letter-reason"
+)
+
+type MetricsManager interface {
+	AddKafkaRejectedEventMetric(ctx context.Context, event string)
+}
+
+type validatable interface {
+	proto.Message
+	ValidateAll() error
+}
+
+// rejectInvalid runs the generated validation on every event, sends the
+// invalid ones to the dead-letter topic and returns the rest.
+func rejectInvalid[T validatable](ctx context.Context, wp *WeatherPublisher, events []T, key func(T) string) ([]T, error) {
+	valid := make([]T, 0, len(events))
+	rejected := make([]kafka.Message, 0)
+
+	for _, event := range events {
+		err := event.ValidateAll()
+		if err == nil {
+			valid = append(valid, event)
+			continue
+		}
+
+		name := string(proto.MessageName(event))
+		logger.Warn(ctx, "event failed validation, sending it to dead-letter topic", slog.String("event", name), slog.Any("error", err))
+		wp.metricsManager.AddKafkaRejectedEventMetric(ctx, name)
+
+		msg, encodeErr := wp.message(ctx, key(event), event)
+		if encodeErr != nil {
+			return nil, encodeErr
+		}
+
+		msg.Headers = append(msg.Headers, kafka.Header{Key: DeadLetterEventHeader, Value: []byte(name)})
+		for _, violation := range violations(err) {
+			msg.Headers = append(msg.Headers, kafka.Header{Key: DeadLetterReasonHeader, Value: []byte(violation.Error())})
+		}
+
+		rejected = append(rejected, msg)
+	}
+
+	if len(rejected) == 0 {
+		return valid, nil
+	}
+
+	if err := wp.deadLetterWriter.WriteMessages(ctx, rejected...); err != nil {
+		logger.Error(ctx, "failed to write messages to dead-letter topic", slog.Any("error", err.Error()))
+		return nil, err
+	}
+
+	return valid, nil
+}
+
+func violations(err error) []error {
+	var multi interface{ AllErrors() []error }
+	if errors.As(err, &multi) {
+		return multi.AllErrors()
+	}
+
+	return []error{err}
+}
@@ -2,35 +2,94 @@ package weather_publisher
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 
 	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/kafka/encoder"
+	"github.com/meteogo/weather-collector-service/internal/kafka/tracing"
 	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
 	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
 	weather_collector_events "github.com/meteogo/weather-collector-service/pkg/events"
 	"github.com/segmentio/kafka-go"
 	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	weatherMessageKey = "current_weather_conditions"
+	weatherMessageKey  = "current_weather_conditions"
+	forecastMessageKey = "weather_forecasts"
 )
 
+// Mode decides how conditions and forecasts are laid out in messages.
+// ModeBatch sends all cities in one CityWeatherConditions or
+// CityWeatherForecasts message. ModePerCity sends one CityWeatherCondition or
+// CityWeatherForecast per message keyed by the city, which keeps per-city
+// ordering on a key-hashing writer and suits a compacted latest-state topic.
+// Conditions and forecasts of a city get different keys, so compaction keeps
+// the latest of each; the ce_type header tells them apart.
+type Mode string
+
+const (
+	ModeBatch   = Mode("batch")
+	ModePerCity = Mode("per_city")
+)
+
+func ParseMode(value string) (Mode, error) {
+	switch mode := Mode(value); mode {
+	case ModeBatch, ModePerCity:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown publish mode %q", value)
+	}
+}
+
 type LibWriter interface {
 	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
 	Close() error
 }
 
+// WeatherPublisher converts measurements into the unit system of its topic
+// and declares it in every event.
+//
+// Events failing the validation rules of the proto are not published, they
+// go to the dead-letter writer with the violations in headers. Alerts are
+// written to their own topic.
 type WeatherPublisher struct {
-	writer LibWriter
+	writer           LibWriter
+	deadLetterWriter LibWriter
+	alertWriter      LibWriter
+	encoder          encoder.Encoder
+	units            enums.UnitSystem
+	mode             Mode
+	source           Source
+	metricsManager   MetricsManager
 }
 
-func NewPublisher(writer LibWriter) *WeatherPublisher {
+func NewPublisher(
+	writer LibWriter,
+	deadLetterWriter LibWriter,
+	alertWriter LibWriter,
+	encoder encoder.Encoder,
+	units enums.UnitSystem,
+	mode Mode,
+	source Source,
+	metricsManager MetricsManager,
+) *WeatherPublisher {
 	return &WeatherPublisher{
-		writer: writer,
+		writer:           writer,
+		deadLetterWriter: deadLetterWriter,
+		alertWriter:      alertWriter,
+		encoder:          encoder,
+		units:            units,
+		mode:             mode,
+		source:           source,
+		metricsManager:   metricsManager,
 	}
 }
 
@@ -45,21 +104,29 @@ func (wp *WeatherPublisher) PublishConditions(ctx context.Context, conditions we
 
 	out := make([]*weather_collector_events.CityWeatherCondition, 0)
 	for _, condition := range conditions {
-		out = append(out, mapCondition(condition))
+		out = append(out, mapCondition(condition, wp.units))
 	}
 
-	jsonBytes, err := json.Marshal(weather_collector_events.CityWeatherConditions{
-		Conditions: out,
+	out, err := rejectInvalid(spanCtx, wp, out, func(c *weather_collector_events.CityWeatherCondition) string {
+		return CityKey(c.GetCity().GetId())
 	})
 	if err != nil {
-		logger.Error(ctx, "failed to Marshal conditions to kafka message", slog.Any("error", err))
 		return err
 	}
 
-	if err := wp.writer.WriteMessages(spanCtx, kafka.Message{
-		Key:   []byte(weatherMessageKey),
-		Value: jsonBytes,
-	}); err != nil {
+	if len(out) == 0 {
+		logger.Warn(ctx, "no valid conditions left, skipping publishing")
+		return nil
+	}
+
+	messages, err := wp.conditionMessages(spanCtx, out)
+	if err != nil {
+		logger.Error(ctx, "failed to encode conditions to kafka message", slog.Any("error", err))
+		return err
+	}
+
+	span.SetAttributes(semconv.MessagingSystemKafka, semconv.MessagingBatchMessageCount(len(messages)))
+	if err := wp.writer.WriteMessages(spanCtx, messages...); err != nil {
 		logger.Error(ctx, "failed to write message to kafka", slog.Any("error", err.Error()))
 		return err
 	}
@@ -68,26 +135,237 @@ func (wp *WeatherPublisher) PublishConditions(ctx context.Context, conditions we
 	return nil
 }
 
-func mapCondition(c weather_service.CityWeatherCondition) *weather_collector_events.CityWeatherCondition {
+func (wp *WeatherPublisher) conditionMessages(ctx context.Context, conditions []*weather_collector_events.CityWeatherCondition) ([]kafka.Message, error) {
+	if wp.mode != ModePerCity {
+		batch := &weather_collector_events.CityWeatherConditions{
+			Conditions: conditions,
+		}
+
+		msg, err := wp.message(ctx, weatherMessageKey, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		return []kafka.Message{msg}, nil
+	}
+
+	messages := make([]kafka.Message, 0, len(conditions))
+	for _, condition := range conditions {
+		msg, err := wp.message(ctx, CityKey(condition.GetCity().GetId()), condition)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
+
+// CityKey is the message key of a city in ModePerCity and of its alerts. It
+// is the id of the city, which survives renames, while the coordinates echoed
+// by providers drift slightly between requests.
+func CityKey(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// ForecastKey is the message key of the forecast of a city in ModePerCity.
+func ForecastKey(id int64) string {
+	return CityKey(id) + "/forecast"
+}
+
+// message encodes the event and wraps it into a CloudEvents binary mode
+// message. The trace context of ctx is propagated in the headers, so
+// consumers can continue the trace of the run that produced the event.
+func (wp *WeatherPublisher) message(ctx context.Context, key string, event proto.Message) (kafka.Message, error) {
+	value, err := wp.encoder.Encode(event)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	cloudEventHeaders, err := wp.source.cloudEventHeaders(key, event)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	headers := append(
+		[]kafka.Header{{Key: encoder.ContentTypeHeader, Value: []byte(wp.encoder.ContentType())}},
+		cloudEventHeaders...,
+	)
+	headers = tracing.Inject(ctx, headers)
+
+	return kafka.Message{
+		Key:     []byte(key),
+		Value:   value,
+		Headers: headers,
+	}, nil
+}
+
+func mapCondition(c weather_service.CityWeatherCondition, units enums.UnitSystem) *weather_collector_events.CityWeatherCondition {
 	return &weather_collector_events.CityWeatherCondition{
 		City: &weather_collector_events.City{
+			Id:   c.City.ID,
 			Name: c.City.Name,
 			Coordinates: &weather_collector_events.Coordinates{
 				Lat:  c.City.Lat,
 				Long: c.City.Long,
 			},
 		},
-		CapturedAt:               timestamppb.New(c.CapturedAt.UTC()),
-		Temperature:              c.Temperature,
-		RelativeHumidityPercent:  uint32(c.RelativeHumidityPercent),
-		WindSpeed:                c.WindSpeed,
-		WeatherCode:              weather_collector_events.WeatherCode(c.WeatherCode),
-		CloudCoverPercent:        uint32(c.CloudCoverPercent),
-		PrecipitationMillimeters: int64(c.Precipitation * enums.Millimeter),
-		VisibilityMillimeters:    int64(c.Visibility * enums.Millimeter),
+		CapturedAt:              timestamppb.New(c.CapturedAt.UTC()),
+		Temperature:             ptr.Map(c.Temperature, func(v enums.Temperature) float64 { return v.In(units.TemperatureUnit()) }),
+		RelativeHumidityPercent: ptr.Map(c.RelativeHumidityPercent, func(v uint8) uint32 { return uint32(v) }),
+		WindSpeed:               ptr.Map(c.WindSpeed, func(v enums.Speed) float64 { return v.In(units.WindSpeedUnit()) }),
+		WeatherCode: ptr.Map(c.WeatherCode, func(v enums.WeatherCode) weather_collector_events.WeatherCode {
+			return weather_collector_events.WeatherCode(v)
+		}),
+		CloudCoverPercent:        ptr.Map(c.CloudCoverPercent, func(v uint8) uint32 { return uint32(v) }),
+		PrecipitationMillimeters: ptr.Map(c.Precipitation, wholeMillimeters),
+		PrecipitationMm:          ptr.Map(c.Precipitation, enums.Length.Millimeters),
+		VisibilityMillimeters:    ptr.Map(c.Visibility, wholeMillimeters),
+		Provider:                 string(c.Provider),
+		Units:                    mapUnits(units),
+		Precipitation:            ptr.Map(c.Precipitation, func(v enums.Length) float64 { return v.In(units.PrecipitationUnit()) }),
+		Visibility:               ptr.Map(c.Visibility, func(v enums.Length) float64 { return v.In(units.VisibilityUnit()) }),
+	}
+}
+
+// wholeMillimeters fills the deprecated integer millimetre fields. Those and
+// the fractional millimetre fields are kept until the next major version of
+// the events, consumers read the lengths in the unit system of the topic.
+func wholeMillimeters(v enums.Length) int64 {
+	return int64(v / enums.Millimeter)
+}
+
+func mapUnits(units enums.UnitSystem) *weather_collector_events.MeasurementUnits {
+	return &weather_collector_events.MeasurementUnits{
+		Temperature:   string(units.TemperatureUnit()),
+		WindSpeed:     string(units.WindSpeedUnit()),
+		Precipitation: string(units.PrecipitationUnit()),
+		Visibility:    string(units.VisibilityUnit()),
+	}
+}
+
+func (wp *WeatherPublisher) PublishForecasts(ctx context.Context, forecasts weather_service.CityWeatherForecasts) error {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.PublishForecasts]", wp))
+	defer span.End()
+
+	if len(forecasts) == 0 {
+		logger.Warn(ctx, "forecasts len is zero, skipping publishing")
+		return nil
+	}
+
+	out := make([]*weather_collector_events.CityWeatherForecast, 0, len(forecasts))
+	for _, forecast := range forecasts {
+		out = append(out, mapForecast(forecast, wp.units))
+	}
+
+	out, err := rejectInvalid(spanCtx, wp, out, func(f *weather_collector_events.CityWeatherForecast) string {
+		return ForecastKey(f.GetCity().GetId())
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(out) == 0 {
+		logger.Warn(ctx, "no valid forecasts left, skipping publishing")
+		return nil
+	}
+
+	messages, err := wp.forecastMessages(spanCtx, out)
+	if err != nil {
+		logger.Error(ctx, "failed to encode forecasts to kafka message", slog.Any("error", err))
+		return err
+	}
+
+	span.SetAttributes(semconv.MessagingSystemKafka, semconv.MessagingBatchMessageCount(len(messages)))
+	if err := wp.writer.WriteMessages(spanCtx, messages...); err != nil {
+		logger.Error(ctx, "failed to write forecasts message to kafka", slog.Any("error", err.Error()))
+		return err
+	}
+
+	logger.Info(ctx, "weather forecasts successfully sent to kafka")
+	return nil
+}
+
+func (wp *WeatherPublisher) forecastMessages(ctx context.Context, forecasts []*weather_collector_events.CityWeatherForecast) ([]kafka.Message, error) {
+	if wp.mode != ModePerCity {
+		batch := &weather_collector_events.CityWeatherForecasts{
+			Forecasts: forecasts,
+		}
+
+		msg, err := wp.message(ctx, forecastMessageKey, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		return []kafka.Message{msg}, nil
+	}
+
+	messages := make([]kafka.Message, 0, len(forecasts))
+	for _, forecast := range forecasts {
+		msg, err := wp.message(ctx, ForecastKey(forecast.GetCity().GetId()), forecast)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
+
+func mapForecast(f weather_service.CityWeatherForecast, units enums.UnitSystem) *weather_collector_events.CityWeatherForecast {
+	hourly := make([]*weather_collector_events.HourlyForecast, 0, len(f.Hourly))
+	for _, h := range f.Hourly {
+		hourly = append(hourly, &weather_collector_events.HourlyForecast{
+			Time:                    timestamppb.New(h.Time.UTC()),
+			Temperature:             ptr.Map(h.Temperature, func(v enums.Temperature) float64 { return v.In(units.TemperatureUnit()) }),
+			RelativeHumidityPercent: ptr.Map(h.RelativeHumidityPercent, func(v uint8) uint32 { return uint32(v) }),
+			WindSpeed:               ptr.Map(h.WindSpeed, func(v enums.Speed) float64 { return v.In(units.WindSpeedUnit()) }),
+			WeatherCode: ptr.Map(h.WeatherCode, func(v enums.WeatherCode) weather_collector_events.WeatherCode {
+				return weather_collector_events.WeatherCode(v)
+			}),
+			CloudCoverPercent:        ptr.Map(h.CloudCoverPercent, func(v uint8) uint32 { return uint32(v) }),
+			PrecipitationMillimeters: ptr.Map(h.Precipitation, wholeMillimeters),
+			PrecipitationMm:          ptr.Map(h.Precipitation, enums.Length.Millimeters),
+			VisibilityMillimeters:    ptr.Map(h.Visibility, wholeMillimeters),
+			Precipitation:            ptr.Map(h.Precipitation, func(v enums.Length) float64 { return v.In(units.PrecipitationUnit()) }),
+			Visibility:               ptr.Map(h.Visibility, func(v enums.Length) float64 { return v.In(units.VisibilityUnit()) }),
+		})
+	}
+
+	daily := make([]*weather_collector_events.DailyForecast, 0, len(f.Daily))
+	for _, d := range f.Daily {
+		daily = append(daily, &weather_collector_events.DailyForecast{
+			Date: timestamppb.New(d.Date.UTC()),
+			WeatherCode: ptr.Map(d.WeatherCode, func(v enums.WeatherCode) weather_collector_events.WeatherCode {
+				return weather_collector_events.WeatherCode(v)
+			}),
+			TemperatureMax:              ptr.Map(d.TemperatureMax, func(v enums.Temperature) float64 { return v.In(units.TemperatureUnit()) }),
+			TemperatureMin:              ptr.Map(d.TemperatureMin, func(v enums.Temperature) float64 { return v.In(units.TemperatureUnit()) }),
+			PrecipitationSumMillimeters: ptr.Map(d.PrecipitationSum, wholeMillimeters),
+			PrecipitationSumMm:          ptr.Map(d.PrecipitationSum, enums.Length.Millimeters),
+			PrecipitationSum:            ptr.Map(d.PrecipitationSum, func(v enums.Length) float64 { return v.In(units.PrecipitationUnit()) }),
+			WindSpeedMax:                ptr.Map(d.WindSpeedMax, func(v enums.Speed) float64 { return v.In(units.WindSpeedUnit()) }),
+		})
+	}
+
+	return &weather_collector_events.CityWeatherForecast{
+		City: &weather_collector_events.City{
+			Id:   f.City.ID,
+			Name: f.City.Name,
+			Coordinates: &weather_collector_events.Coordinates{
+				Lat:  f.City.Lat,
+				Long: f.City.Long,
+			},
+		},
+		CapturedAt: timestamppb.New(f.CapturedAt.UTC()),
+		Hourly:     hourly,
+		Daily:      daily,
+		Units:      mapUnits(units),
 	}
 }
 
 func (wp *WeatherPublisher) Close(ctx context.Context) error {
-	return wp.writer.Close()
+	return errors.Join(wp.writer.Close(), wp.deadLetterWriter.Close(), wp.alertWriter.Close())
 }
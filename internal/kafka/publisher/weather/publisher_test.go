@@ -0,0 +1,414 @@
+package weather_publisher_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/kafka/encoder"
+	weather_publisher "github.com/meteogo/weather-collector-service/internal/kafka/publisher/weather"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/alert_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	weather_collector_events "github.com/meteogo/weather-collector-service/pkg/events"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+var testSource = weather_publisher.Source{
+	Application: "weather-collector-service",
+	Env:         "TESTING",
+}
+
+type recordingWriter struct {
+	messages []kafka.Message
+}
+
+func (w *recordingWriter) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	w.messages = append(w.messages, msgs...)
+	return nil
+}
+
+func (w *recordingWriter) Close() error {
+	return nil
+}
+
+type countingMetrics struct {
+	rejected map[string]int
+}
+
+func (m *countingMetrics) AddKafkaRejectedEventMetric(_ context.Context, event string) {
+	m.rejected[event]++
+}
+
+func TestWeatherPublisher_PublishConditions(t *testing.T) {
+	t.Parallel()
+
+	capturedAt := time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC)
+	conditions := weather_service.CityWeatherConditions{
+		{
+			City:        weather_service.City{ID: 1, Name: "Berlin"},
+			CapturedAt:  capturedAt,
+			Temperature: ptr.To(enums.Celsius(12.5)),
+		},
+		{
+			City:        weather_service.City{ID: 2, Name: "Paris"},
+			CapturedAt:  capturedAt,
+			Temperature: ptr.To(enums.Celsius(8)),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		mode     weather_publisher.Mode
+		wantKeys []string
+	}{
+		{
+			name:     "batch",
+			mode:     weather_publisher.ModeBatch,
+			wantKeys: []string{"current_weather_conditions"},
+		},
+		{
+			name:     "per city",
+			mode:     weather_publisher.ModePerCity,
+			wantKeys: []string{"1", "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			enc, err := encoder.New(encoder.FormatProto)
+			require.NoError(t, err)
+
+			writer := &recordingWriter{}
+			publisher := weather_publisher.NewPublisher(writer, &recordingWriter{}, &recordingWriter{}, enc, enums.UnitSystemMetric, tt.mode, testSource, &countingMetrics{})
+			require.NoError(t, publisher.PublishConditions(context.Background(), conditions))
+
+			keys := make([]string, 0, len(writer.messages))
+			for _, msg := range writer.messages {
+				keys = append(keys, string(msg.Key))
+			}
+			assert.Equal(t, tt.wantKeys, keys)
+
+			if tt.mode != weather_publisher.ModePerCity {
+				return
+			}
+
+			for i, msg := range writer.messages {
+				var event weather_collector_events.CityWeatherCondition
+				require.NoError(t, proto.Unmarshal(msg.Value, &event))
+				assert.Equal(t, conditions[i].City.Name, event.GetCity().GetName())
+				assert.InDelta(t, conditions[i].Temperature.Celsius(), event.GetTemperature(), 1e-9)
+			}
+		})
+	}
+}
+
+func TestWeatherPublisher_PublishForecasts(t *testing.T) {
+	t.Parallel()
+
+	capturedAt := time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC)
+	forecasts := weather_service.CityWeatherForecasts{
+		{
+			City:       weather_service.City{ID: 1, Name: "Berlin"},
+			CapturedAt: capturedAt,
+			Hourly:     []weather_service.HourlyForecast{{Time: capturedAt, Temperature: ptr.To(enums.Celsius(12.5))}},
+		},
+		{
+			City:       weather_service.City{ID: 2, Name: "Paris"},
+			CapturedAt: capturedAt,
+			Hourly:     []weather_service.HourlyForecast{{Time: capturedAt, Temperature: ptr.To(enums.Celsius(8))}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		mode     weather_publisher.Mode
+		wantKeys []string
+		wantType string
+	}{
+		{
+			name:     "batch",
+			mode:     weather_publisher.ModeBatch,
+			wantKeys: []string{"weather_forecasts"},
+			wantType: "weather_collector_events.CityWeatherForecasts",
+		},
+		{
+			name:     "per city",
+			mode:     weather_publisher.ModePerCity,
+			wantKeys: []string{"1/forecast", "2/forecast"},
+			wantType: "weather_collector_events.CityWeatherForecast",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			enc, err := encoder.New(encoder.FormatProto)
+			require.NoError(t, err)
+
+			writer := &recordingWriter{}
+			publisher := weather_publisher.NewPublisher(writer, &recordingWriter{}, &recordingWriter{}, enc, enums.UnitSystemMetric, tt.mode, testSource, &countingMetrics{})
+			require.NoError(t, publisher.PublishForecasts(context.Background(), forecasts))
+
+			keys := make([]string, 0, len(writer.messages))
+			for _, msg := range writer.messages {
+				keys = append(keys, string(msg.Key))
+				for _, header := range msg.Headers {
+					if header.Key == weather_publisher.CloudEventsTypeHeader {
+						assert.Equal(t, tt.wantType, string(header.Value))
+					}
+				}
+			}
+			assert.Equal(t, tt.wantKeys, keys)
+
+			if tt.mode != weather_publisher.ModePerCity {
+				var batch weather_collector_events.CityWeatherForecasts
+				require.NoError(t, proto.Unmarshal(writer.messages[0].Value, &batch))
+				require.Len(t, batch.GetForecasts(), len(forecasts))
+				return
+			}
+
+			for i, msg := range writer.messages {
+				var event weather_collector_events.CityWeatherForecast
+				require.NoError(t, proto.Unmarshal(msg.Value, &event))
+				assert.Equal(t, forecasts[i].City.ID, event.GetCity().GetId())
+				assert.InDelta(t, forecasts[i].Hourly[0].Temperature.Celsius(), event.GetHourly()[0].GetTemperature(), 1e-9)
+				assert.Nil(t, event.GetHourly()[0].WindSpeed)
+			}
+		})
+	}
+}
+
+func TestWeatherPublisher_ContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		format          encoder.Format
+		wantContentType string
+	}{
+		{format: encoder.FormatProto, wantContentType: "application/x-protobuf"},
+		{format: encoder.FormatProtoJSON, wantContentType: "application/x-protobuf+json"},
+		{format: encoder.FormatJSON, wantContentType: "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			t.Parallel()
+
+			enc, err := encoder.New(tt.format)
+			require.NoError(t, err)
+
+			writer := &recordingWriter{}
+			publisher := weather_publisher.NewPublisher(writer, &recordingWriter{}, &recordingWriter{}, enc, enums.UnitSystemMetric, weather_publisher.ModeBatch, testSource, &countingMetrics{})
+			require.NoError(t, publisher.PublishConditions(context.Background(), weather_service.CityWeatherConditions{
+				{City: weather_service.City{ID: 1, Name: "Berlin"}, CapturedAt: time.Now()},
+			}))
+			require.Len(t, writer.messages, 1)
+
+			var contentTypes []string
+			for _, header := range writer.messages[0].Headers {
+				if header.Key == encoder.ContentTypeHeader {
+					contentTypes = append(contentTypes, string(header.Value))
+				}
+			}
+			assert.Equal(t, []string{tt.wantContentType}, contentTypes)
+		})
+	}
+}
+
+func TestWeatherPublisher_PublishConditionsDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	enc, err := encoder.New(encoder.FormatProto)
+	require.NoError(t, err)
+
+	var (
+		writer     = &recordingWriter{}
+		deadLetter = &recordingWriter{}
+		metrics    = &countingMetrics{rejected: make(map[string]int)}
+		publisher  = weather_publisher.NewPublisher(writer, deadLetter, &recordingWriter{}, enc, enums.UnitSystemMetric, weather_publisher.ModePerCity, testSource, metrics)
+		capturedAt = time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC)
+	)
+
+	err = publisher.PublishConditions(context.Background(), weather_service.CityWeatherConditions{
+		{
+			City:                    weather_service.City{ID: 1, Name: "Berlin"},
+			CapturedAt:              capturedAt,
+			RelativeHumidityPercent: ptr.To[uint8](55),
+		},
+		{
+			City:                    weather_service.City{ID: 2, Name: "Paris", Coordinates: weather_service.Coordinates{Lat: 91}},
+			CapturedAt:              capturedAt,
+			RelativeHumidityPercent: ptr.To[uint8](130),
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, writer.messages, 1)
+	assert.Equal(t, "1", string(writer.messages[0].Key))
+
+	require.Len(t, deadLetter.messages, 1)
+	assert.Equal(t, "2", string(deadLetter.messages[0].Key))
+
+	reasons := make([]string, 0)
+	for _, header := range deadLetter.messages[0].Headers {
+		switch header.Key {
+		case weather_publisher.DeadLetterEventHeader:
+			assert.Equal(t, "weather_collector_events.CityWeatherCondition", string(header.Value))
+		case weather_publisher.DeadLetterReasonHeader:
+			reasons = append(reasons, string(header.Value))
+		}
+	}
+	require.Len(t, reasons, 2)
+	assert.Contains(t, reasons[0], "Lat")
+	assert.Contains(t, reasons[1], "RelativeHumidityPercent")
+
+	assert.Equal(t, map[string]int{"weather_collector_events.CityWeatherCondition": 1}, metrics.rejected)
+}
+
+func TestWeatherPublisher_CloudEventsHeaders(t *testing.T) {
+	t.Parallel()
+
+	enc, err := encoder.New(encoder.FormatJSON)
+	require.NoError(t, err)
+
+	writer := &recordingWriter{}
+	publisher := weather_publisher.NewPublisher(writer, &recordingWriter{}, &recordingWriter{}, enc, enums.UnitSystemMetric, weather_publisher.ModePerCity, testSource, &countingMetrics{})
+
+	capturedAt := time.Date(2025, time.May, 3, 13, 0, 0, 0, time.UTC)
+	conditions := weather_service.CityWeatherConditions{
+		{City: weather_service.City{ID: 1, Name: "Berlin"}, CapturedAt: capturedAt},
+		{City: weather_service.City{ID: 2, Name: "Paris"}, CapturedAt: capturedAt.Add(time.Minute)},
+	}
+
+	// The second call is a retry of the same outbox entries.
+	require.NoError(t, publisher.PublishConditions(context.Background(), conditions))
+	require.NoError(t, publisher.PublishConditions(context.Background(), conditions))
+	require.Len(t, writer.messages, 4)
+
+	headers := make([]map[string]string, 0, len(writer.messages))
+	for i, msg := range writer.messages {
+		msgHeaders := make(map[string]string)
+		for _, header := range msg.Headers {
+			msgHeaders[header.Key] = string(header.Value)
+		}
+		headers = append(headers, msgHeaders)
+
+		assert.Equal(t, "1.0", msgHeaders[weather_publisher.CloudEventsSpecVersionHeader])
+		assert.Equal(t, "weather-collector-service", msgHeaders[weather_publisher.CloudEventsSourceHeader])
+		assert.Equal(t, "weather_collector_events.CityWeatherCondition", msgHeaders[weather_publisher.CloudEventsTypeHeader])
+		assert.Equal(t, "TESTING", msgHeaders[weather_publisher.CloudEventsEnvHeader])
+		assert.Equal(t, string(msg.Key), msgHeaders[weather_publisher.CloudEventsPartitionKeyHeader])
+		assert.Equal(t, "application/json", msgHeaders[encoder.ContentTypeHeader])
+		assert.NotEmpty(t, msgHeaders[weather_publisher.CloudEventsIDHeader])
+
+		eventTime, err := time.Parse(time.RFC3339Nano, msgHeaders[weather_publisher.CloudEventsTimeHeader])
+		require.NoError(t, err)
+		assert.Equal(t, conditions[i%2].CapturedAt, eventTime)
+	}
+
+	assert.NotEqual(t, headers[0][weather_publisher.CloudEventsIDHeader], headers[1][weather_publisher.CloudEventsIDHeader])
+	assert.Equal(t, headers[0][weather_publisher.CloudEventsIDHeader], headers[2][weather_publisher.CloudEventsIDHeader])
+	assert.Equal(t, headers[1][weather_publisher.CloudEventsIDHeader], headers[3][weather_publisher.CloudEventsIDHeader])
+}
+
+func TestWeatherPublisher_PublishAlerts(t *testing.T) {
+	t.Parallel()
+
+	enc, err := encoder.New(encoder.FormatProto)
+	require.NoError(t, err)
+
+	var (
+		writer     = &recordingWriter{}
+		alerts     = &recordingWriter{}
+		capturedAt = time.Date(2025, 7, 10, 12, 0, 0, 0, time.UTC)
+		publisher  = weather_publisher.NewPublisher(writer, &recordingWriter{}, alerts, enc, enums.UnitSystemImperial, weather_publisher.ModeBatch, testSource, &countingMetrics{})
+	)
+
+	err = publisher.PublishAlerts(context.Background(), alert_service.Alerts{
+		{
+			Rule: alert_service.Rule{
+				Name:        "strong_wind",
+				Description: "Wind speed above 50 km/h",
+				Severity:    alert_service.SeverityWarning,
+			},
+			State: alert_service.StateRaised,
+			Condition: weather_service.CityWeatherCondition{
+				City:       weather_service.City{ID: 3, Name: "New York"},
+				CapturedAt: capturedAt,
+				WindSpeed:  ptr.To(enums.KilometersPerHour(80.4672)),
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, writer.messages)
+	require.Len(t, alerts.messages, 1)
+	assert.Equal(t, "3", string(alerts.messages[0].Key))
+
+	var alert weather_collector_events.WeatherAlert
+	require.NoError(t, proto.Unmarshal(alerts.messages[0].Value, &alert))
+	assert.Equal(t, "strong_wind", alert.GetRule())
+	assert.Equal(t, weather_collector_events.AlertSeverity_ALERT_SEVERITY_WARNING, alert.GetSeverity())
+	assert.Equal(t, weather_collector_events.AlertState_ALERT_STATE_RAISED, alert.GetState())
+	assert.Equal(t, "New York", alert.GetCity().GetName())
+	assert.Equal(t, capturedAt, alert.GetChangedAt().AsTime())
+	assert.InDelta(t, 50, alert.GetCondition().GetWindSpeed(), 1e-9)
+	assert.Equal(t, "mph", alert.GetCondition().GetUnits().GetWindSpeed())
+}
+
+func TestWeatherPublisher_PropagatesTraceContext(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	enc, err := encoder.New(encoder.FormatProto)
+	require.NoError(t, err)
+
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	require.NoError(t, err)
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	require.NoError(t, err)
+
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	writer := &recordingWriter{}
+	publisher := weather_publisher.NewPublisher(writer, &recordingWriter{}, &recordingWriter{}, enc, enums.UnitSystemMetric, weather_publisher.ModeBatch, testSource, &countingMetrics{})
+	require.NoError(t, publisher.PublishConditions(ctx, weather_service.CityWeatherConditions{
+		{City: weather_service.City{ID: 1, Name: "Berlin"}, CapturedAt: time.Now()},
+	}))
+	require.Len(t, writer.messages, 1)
+
+	var traceparent string
+	for _, header := range writer.messages[0].Headers {
+		if header.Key == "traceparent" {
+			traceparent = string(header.Value)
+		}
+	}
+
+	// No SDK is installed, so the publish span is a no-op and the parent
+	// span context is the one propagated.
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", traceparent)
+}
+
+func TestParseMode(t *testing.T) {
+	t.Parallel()
+
+	mode, err := weather_publisher.ParseMode("per_city")
+	require.NoError(t, err)
+	assert.Equal(t, weather_publisher.ModePerCity, mode)
+
+	_, err = weather_publisher.ParseMode("per_country")
+	assert.Error(t, err)
+}
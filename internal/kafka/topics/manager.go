@@ -0,0 +1,254 @@
+package topics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/segmentio/kafka-go"
+)
+
+const cleanupPolicyConfig = "cleanup.policy"
+
+// Spec is the expected shape of a topic. Zero Partitions or ReplicationFactor
+// and an empty CleanupPolicy are not checked and left to broker defaults on
+// creation.
+type Spec struct {
+	Name              string
+	Partitions        int
+	ReplicationFactor int
+	CleanupPolicy     string
+}
+
+type Client interface {
+	Metadata(ctx context.Context, req *kafka.MetadataRequest) (*kafka.MetadataResponse, error)
+	CreateTopics(ctx context.Context, req *kafka.CreateTopicsRequest) (*kafka.CreateTopicsResponse, error)
+	DescribeConfigs(ctx context.Context, req *kafka.DescribeConfigsRequest) (*kafka.DescribeConfigsResponse, error)
+}
+
+// Manager provisions the topics the service writes to and tells whether the
+// cluster is reachable.
+type Manager struct {
+	client Client
+	create bool
+	specs  []Spec
+}
+
+func NewManager(client Client, create bool, specs ...Spec) *Manager {
+	return &Manager{
+		client: client,
+		create: create,
+		specs:  specs,
+	}
+}
+
+// Ensure creates missing topics when creation is enabled and compares the
+// existing ones with their spec. Every problem found is returned, joined.
+func (m *Manager) Ensure(ctx context.Context) error {
+	topics, err := m.describe(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs    []error
+		missing []Spec
+	)
+
+	for _, spec := range m.specs {
+		topic, ok := topics[spec.Name]
+		if !ok {
+			missing = append(missing, spec)
+			continue
+		}
+
+		errs = append(errs, m.validate(ctx, spec, topic)...)
+	}
+
+	if len(missing) > 0 {
+		if err := m.createTopics(ctx, missing); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Ready fails when no broker answers, a topic does not exist or differs from
+// its spec, it is meant to back a readiness probe. Unlike Ensure it never
+// creates topics.
+func (m *Manager) Ready(ctx context.Context) error {
+	topics, err := m.describe(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, spec := range m.specs {
+		topic, ok := topics[spec.Name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("kafka topic %s does not exist", spec.Name))
+			continue
+		}
+
+		errs = append(errs, m.validate(ctx, spec, topic)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// describe returns the metadata of the managed topics that exist, by name.
+func (m *Manager) describe(ctx context.Context) (map[string]kafka.Topic, error) {
+	names := make([]string, 0, len(m.specs))
+	for _, spec := range m.specs {
+		names = append(names, spec.Name)
+	}
+
+	resp, err := m.client.Metadata(ctx, &kafka.MetadataRequest{Topics: names})
+	if err != nil {
+		return nil, fmt.Errorf("unable to reach kafka brokers: %w", err)
+	}
+
+	topics := make(map[string]kafka.Topic, len(resp.Topics))
+	for _, topic := range resp.Topics {
+		if errors.Is(topic.Error, kafka.UnknownTopicOrPartition) {
+			continue
+		}
+
+		if topic.Error != nil {
+			return nil, fmt.Errorf("unable to describe kafka topic %s: %w", topic.Name, topic.Error)
+		}
+
+		topics[topic.Name] = topic
+	}
+
+	return topics, nil
+}
+
+func (m *Manager) validate(ctx context.Context, spec Spec, topic kafka.Topic) []error {
+	var errs []error
+
+	if spec.Partitions > 0 && len(topic.Partitions) != spec.Partitions {
+		errs = append(errs, fmt.Errorf("kafka topic %s has %d partitions, expected %d", spec.Name, len(topic.Partitions), spec.Partitions))
+	}
+
+	if spec.ReplicationFactor > 0 {
+		for _, partition := range topic.Partitions {
+			if len(partition.Replicas) != spec.ReplicationFactor {
+				errs = append(errs, fmt.Errorf("kafka topic %s has replication factor %d, expected %d", spec.Name, len(partition.Replicas), spec.ReplicationFactor))
+				break
+			}
+		}
+	}
+
+	if spec.CleanupPolicy != "" {
+		policy, err := m.cleanupPolicy(ctx, spec.Name)
+		if err != nil {
+			errs = append(errs, err)
+		} else if normalizePolicy(policy) != normalizePolicy(spec.CleanupPolicy) {
+			errs = append(errs, fmt.Errorf("kafka topic %s has cleanup policy %q, expected %q", spec.Name, policy, spec.CleanupPolicy))
+		}
+	}
+
+	return errs
+}
+
+func (m *Manager) cleanupPolicy(ctx context.Context, topic string) (string, error) {
+	resp, err := m.client.DescribeConfigs(ctx, &kafka.DescribeConfigsRequest{
+		Resources: []kafka.DescribeConfigRequestResource{
+			{
+				ResourceType: kafka.ResourceTypeTopic,
+				ResourceName: topic,
+				ConfigNames:  []string{cleanupPolicyConfig},
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to describe config of kafka topic %s: %w", topic, err)
+	}
+
+	for _, resource := range resp.Resources {
+		if resource.Error != nil {
+			return "", fmt.Errorf("unable to describe config of kafka topic %s: %w", topic, resource.Error)
+		}
+
+		for _, entry := range resource.ConfigEntries {
+			if entry.ConfigName == cleanupPolicyConfig {
+				return entry.ConfigValue, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("kafka topic %s does not report %s", topic, cleanupPolicyConfig)
+}
+
+func (m *Manager) createTopics(ctx context.Context, specs []Spec) error {
+	if !m.create {
+		names := make([]string, 0, len(specs))
+		for _, spec := range specs {
+			names = append(names, spec.Name)
+		}
+
+		return fmt.Errorf("kafka topics %s do not exist and topic creation is disabled", strings.Join(names, ", "))
+	}
+
+	configs := make([]kafka.TopicConfig, 0, len(specs))
+	for _, spec := range specs {
+		config := kafka.TopicConfig{
+			Topic:             spec.Name,
+			NumPartitions:     -1,
+			ReplicationFactor: -1,
+		}
+
+		if spec.Partitions > 0 {
+			config.NumPartitions = spec.Partitions
+		}
+
+		if spec.ReplicationFactor > 0 {
+			config.ReplicationFactor = spec.ReplicationFactor
+		}
+
+		if spec.CleanupPolicy != "" {
+			config.ConfigEntries = []kafka.ConfigEntry{
+				{ConfigName: cleanupPolicyConfig, ConfigValue: spec.CleanupPolicy},
+			}
+		}
+
+		configs = append(configs, config)
+	}
+
+	resp, err := m.client.CreateTopics(ctx, &kafka.CreateTopicsRequest{Topics: configs})
+	if err != nil {
+		return fmt.Errorf("unable to create kafka topics: %w", err)
+	}
+
+	var errs []error
+	for _, spec := range specs {
+		err := resp.Errors[spec.Name]
+		switch {
+		case err == nil:
+			logger.Info(ctx, "kafka topic created", slog.String("topic", spec.Name), slog.Int("partitions", spec.Partitions), slog.Int("replicationFactor", spec.ReplicationFactor))
+		case errors.Is(err, kafka.TopicAlreadyExists):
+			logger.Info(ctx, "kafka topic was created concurrently", slog.String("topic", spec.Name))
+		default:
+			errs = append(errs, fmt.Errorf("unable to create kafka topic %s: %w", spec.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// normalizePolicy makes "delete,compact" and "compact, delete" compare equal.
+func normalizePolicy(policy string) string {
+	parts := strings.Split(policy, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	slices.Sort(parts)
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,201 @@
+package topics_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/kafka/topics"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient struct {
+	topics        []kafka.Topic
+	cleanupPolicy string
+	metadataErr   error
+	created       []kafka.TopicConfig
+}
+
+func (c *fakeClient) Metadata(_ context.Context, req *kafka.MetadataRequest) (*kafka.MetadataResponse, error) {
+	if c.metadataErr != nil {
+		return nil, c.metadataErr
+	}
+
+	resp := &kafka.MetadataResponse{}
+	for _, name := range req.Topics {
+		topic := kafka.Topic{Name: name, Error: kafka.UnknownTopicOrPartition}
+		for _, existing := range c.topics {
+			if existing.Name == name {
+				topic = existing
+			}
+		}
+
+		resp.Topics = append(resp.Topics, topic)
+	}
+
+	return resp, nil
+}
+
+func (c *fakeClient) CreateTopics(_ context.Context, req *kafka.CreateTopicsRequest) (*kafka.CreateTopicsResponse, error) {
+	c.created = append(c.created, req.Topics...)
+	return &kafka.CreateTopicsResponse{Errors: map[string]error{}}, nil
+}
+
+func (c *fakeClient) DescribeConfigs(_ context.Context, req *kafka.DescribeConfigsRequest) (*kafka.DescribeConfigsResponse, error) {
+	return &kafka.DescribeConfigsResponse{
+		Resources: []kafka.DescribeConfigResponseResource{
+			{
+				ResourceName: req.Resources[0].ResourceName,
+				ConfigEntries: []kafka.DescribeConfigResponseConfigEntry{
+					{ConfigName: "cleanup.policy", ConfigValue: c.cleanupPolicy},
+				},
+			},
+		},
+	}, nil
+}
+
+func topic(name string, partitions, replicas int) kafka.Topic {
+	t := kafka.Topic{Name: name}
+	for i := 0; i < partitions; i++ {
+		t.Partitions = append(t.Partitions, kafka.Partition{Topic: name, ID: i, Replicas: make([]kafka.Broker, replicas)})
+	}
+
+	return t
+}
+
+func TestManager_Ensure(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	spec := topics.Spec{
+		Name:              "weather",
+		Partitions:        3,
+		ReplicationFactor: 2,
+		CleanupPolicy:     "compact",
+	}
+
+	tests := []struct {
+		name        string
+		client      *fakeClient
+		create      bool
+		wantCreated []kafka.TopicConfig
+		wantErr     string
+	}{
+		{
+			name:   "matching topic",
+			client: &fakeClient{topics: []kafka.Topic{topic("weather", 3, 2)}, cleanupPolicy: "compact"},
+		},
+		{
+			name:   "missing topic is created",
+			client: &fakeClient{},
+			create: true,
+			wantCreated: []kafka.TopicConfig{
+				{
+					Topic:             "weather",
+					NumPartitions:     3,
+					ReplicationFactor: 2,
+					ConfigEntries:     []kafka.ConfigEntry{{ConfigName: "cleanup.policy", ConfigValue: "compact"}},
+				},
+			},
+		},
+		{
+			name:    "missing topic with creation disabled",
+			client:  &fakeClient{},
+			wantErr: "do not exist and topic creation is disabled",
+		},
+		{
+			name:    "partition count mismatch",
+			client:  &fakeClient{topics: []kafka.Topic{topic("weather", 1, 2)}, cleanupPolicy: "compact"},
+			wantErr: "has 1 partitions, expected 3",
+		},
+		{
+			name:    "replication factor mismatch",
+			client:  &fakeClient{topics: []kafka.Topic{topic("weather", 3, 1)}, cleanupPolicy: "compact"},
+			wantErr: "has replication factor 1, expected 2",
+		},
+		{
+			name:    "cleanup policy mismatch",
+			client:  &fakeClient{topics: []kafka.Topic{topic("weather", 3, 2)}, cleanupPolicy: "delete"},
+			wantErr: `has cleanup policy "delete", expected "compact"`,
+		},
+		{
+			name:    "brokers unreachable",
+			client:  &fakeClient{metadataErr: errors.New("dial tcp: connection refused")},
+			create:  true,
+			wantErr: "unable to reach kafka brokers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := topics.NewManager(tt.client, tt.create, spec).Ensure(context.Background())
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+
+			assert.Equal(t, tt.wantCreated, tt.client.created)
+		})
+	}
+}
+
+func TestManager_Ready(t *testing.T) {
+	t.Parallel()
+
+	spec := topics.Spec{Name: "weather", Partitions: 3, ReplicationFactor: 2, CleanupPolicy: "compact"}
+
+	tests := []struct {
+		name    string
+		client  *fakeClient
+		wantErr string
+	}{
+		{
+			name:   "matching topic",
+			client: &fakeClient{topics: []kafka.Topic{topic("weather", 3, 2)}, cleanupPolicy: "compact"},
+		},
+		{
+			name:    "missing topic",
+			client:  &fakeClient{},
+			wantErr: "does not exist",
+		},
+		{
+			name:    "partitions differ",
+			client:  &fakeClient{topics: []kafka.Topic{topic("weather", 1, 2)}, cleanupPolicy: "compact"},
+			wantErr: "has 1 partitions, expected 3",
+		},
+		{
+			name:    "cleanup policy differs",
+			client:  &fakeClient{topics: []kafka.Topic{topic("weather", 3, 2)}, cleanupPolicy: "delete"},
+			wantErr: `has cleanup policy "delete", expected "compact"`,
+		},
+		{
+			name:    "brokers unreachable",
+			client:  &fakeClient{metadataErr: errors.New("timeout")},
+			wantErr: "unable to reach kafka brokers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := topics.NewManager(tt.client, true, spec).Ready(context.Background())
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, tt.client.created)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+var _ propagation.TextMapCarrier = &HeaderCarrier{}
+
+// HeaderCarrier lets an OTel propagator read and write kafka message headers.
+type HeaderCarrier struct {
+	Headers *[]kafka.Header
+}
+
+func (c *HeaderCarrier) Get(key string) string {
+	for _, header := range *c.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+func (c *HeaderCarrier) Set(key, value string) {
+	for i, header := range *c.Headers {
+		if header.Key == key {
+			(*c.Headers)[i].Value = []byte(value)
+			return
+		}
+	}
+
+	*c.Headers = append(*c.Headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c *HeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c.Headers))
+	for _, header := range *c.Headers {
+		keys = append(keys, header.Key)
+	}
+
+	return keys
+}
+
+// Inject writes the trace context of ctx into the headers with the global
+// propagator, that is traceparent and tracestate for the W3C one.
+func Inject(ctx context.Context, headers []kafka.Header) []kafka.Header {
+	otel.GetTextMapPropagator().Inject(ctx, &HeaderCarrier{Headers: &headers})
+	return headers
+}
+
+// Extract returns ctx carrying the trace context found in the headers.
+func Extract(ctx context.Context, headers []kafka.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, &HeaderCarrier{Headers: &headers})
+}
@@ -14,6 +14,8 @@ import (
 type Manager struct {
 	openMeteoFetchDuration prometheus.Histogram
 	kafkaSendDuration      prometheus.Histogram
+	kafkaRejectedEvents    *prometheus.CounterVec
+	configReloads          *prometheus.CounterVec
 }
 
 func NewManager() *Manager {
@@ -25,6 +27,16 @@ func NewManager() *Manager {
 		kafkaSendDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
 			Name: "kafka_send_duration_ms",
 		}),
+
+		kafkaRejectedEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "kafka_rejected_events_total",
+			Help: "Events that failed validation and were sent to the dead-letter topic.",
+		}, []string{"event"}),
+
+		configReloads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "config_reloads_total",
+			Help: "Reloads of the config file by result, rejected ones kept at least one previous value.",
+		}, []string{"result"}),
 	}
 }
 
@@ -33,6 +45,8 @@ func (m *Manager) Start(ctx context.Context) {
 
 	prometheus.MustRegister(m.openMeteoFetchDuration)
 	prometheus.MustRegister(m.kafkaSendDuration)
+	prometheus.MustRegister(m.kafkaRejectedEvents)
+	prometheus.MustRegister(m.configReloads)
 
 	http.Handle("/metrics", promhttp.Handler())
 	go http.ListenAndServe(":2112", nil)
@@ -44,3 +58,11 @@ func (m *Manager) AddMeteoClientDurationMetric(ctx context.Context, d time.Durat
 func (m *Manager) AddKafkaSendDurationMetric(ctx context.Context, d time.Duration) {
 	m.kafkaSendDuration.Observe(float64(d.Milliseconds()))
 }
+
+func (m *Manager) AddKafkaRejectedEventMetric(ctx context.Context, event string) {
+	m.kafkaRejectedEvents.WithLabelValues(event).Inc()
+}
+
+func (m *Manager) AddConfigReloadMetric(ctx context.Context, result string) {
+	m.configReloads.WithLabelValues(result).Inc()
+}
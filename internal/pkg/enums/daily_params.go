@@ -0,0 +1,59 @@
+package enums
+
+type DailyParam string
+
+const (
+	DailyParamWeatherCode      = DailyParam("weatherCode")
+	DailyParamTemperatureMax   = DailyParam("temperatureMax")
+	DailyParamTemperatureMin   = DailyParam("temperatureMin")
+	DailyParamPrecipitationSum = DailyParam("precipitationSum")
+	DailyParamWindSpeedMax     = DailyParam("windSpeedMax")
+)
+
+// DailyVariable describes how a daily forecast param is requested from
+// Open-Meteo and how its value has to be read, like Variable does for the
+// monitoring params.
+type DailyVariable struct {
+	Param DailyParam
+	Field string
+	Unit  Unit
+	Type  ValueType
+}
+
+var dailyVariables = map[DailyParam]DailyVariable{
+	DailyParamWeatherCode: {
+		Param: DailyParamWeatherCode,
+		Field: "weather_code",
+		Unit:  UnitWMOCode,
+		Type:  ValueTypeInteger,
+	},
+	DailyParamTemperatureMax: {
+		Param: DailyParamTemperatureMax,
+		Field: "temperature_2m_max",
+		Unit:  UnitCelsius,
+		Type:  ValueTypeFloat,
+	},
+	DailyParamTemperatureMin: {
+		Param: DailyParamTemperatureMin,
+		Field: "temperature_2m_min",
+		Unit:  UnitCelsius,
+		Type:  ValueTypeFloat,
+	},
+	DailyParamPrecipitationSum: {
+		Param: DailyParamPrecipitationSum,
+		Field: "precipitation_sum",
+		Unit:  UnitMillimeter,
+		Type:  ValueTypeFloat,
+	},
+	DailyParamWindSpeedMax: {
+		Param: DailyParamWindSpeedMax,
+		Field: "wind_speed_10m_max",
+		Unit:  UnitKilometersPerHour,
+		Type:  ValueTypeFloat,
+	},
+}
+
+func LookupDailyVariable(param DailyParam) (DailyVariable, bool) {
+	v, ok := dailyVariables[param]
+	return v, ok
+}
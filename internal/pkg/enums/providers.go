@@ -0,0 +1,8 @@
+package enums
+
+type Provider string
+
+const (
+	ProviderOpenMeteo = Provider("open_meteo")
+	ProviderMetNorway = Provider("met_norway")
+)
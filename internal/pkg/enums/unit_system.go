@@ -0,0 +1,128 @@
+package enums
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type (
+	TemperatureUnit   string
+	WindSpeedUnit     string
+	PrecipitationUnit string
+)
+
+// Option values accepted by Open-Meteo's temperature_unit, wind_speed_unit and
+// precipitation_unit query params.
+const (
+	TemperatureUnitCelsius    = TemperatureUnit("celsius")
+	TemperatureUnitFahrenheit = TemperatureUnit("fahrenheit")
+
+	WindSpeedUnitKilometersPerHour = WindSpeedUnit("kmh")
+	WindSpeedUnitMetersPerSecond   = WindSpeedUnit("ms")
+	WindSpeedUnitMilesPerHour      = WindSpeedUnit("mph")
+	WindSpeedUnitKnots             = WindSpeedUnit("kn")
+
+	PrecipitationUnitMillimeter = PrecipitationUnit("mm")
+	PrecipitationUnitInch       = PrecipitationUnit("inch")
+)
+
+type UnitSystem struct {
+	Temperature   TemperatureUnit   `json:"temperature"`
+	WindSpeed     WindSpeedUnit     `json:"windSpeed"`
+	Precipitation PrecipitationUnit `json:"precipitation"`
+}
+
+var (
+	UnitSystemMetric = UnitSystem{
+		Temperature:   TemperatureUnitCelsius,
+		WindSpeed:     WindSpeedUnitKilometersPerHour,
+		Precipitation: PrecipitationUnitMillimeter,
+	}
+
+	UnitSystemImperial = UnitSystem{
+		Temperature:   TemperatureUnitFahrenheit,
+		WindSpeed:     WindSpeedUnitMilesPerHour,
+		Precipitation: PrecipitationUnitInch,
+	}
+)
+
+var (
+	temperatureUnits = map[TemperatureUnit]Unit{
+		TemperatureUnitCelsius:    UnitCelsius,
+		TemperatureUnitFahrenheit: UnitFahrenheit,
+	}
+
+	windSpeedUnits = map[WindSpeedUnit]Unit{
+		WindSpeedUnitKilometersPerHour: UnitKilometersPerHour,
+		WindSpeedUnitMetersPerSecond:   UnitMetersPerSecond,
+		WindSpeedUnitMilesPerHour:      UnitMilesPerHour,
+		WindSpeedUnitKnots:             UnitKnots,
+	}
+
+	precipitationUnits = map[PrecipitationUnit]Unit{
+		PrecipitationUnitMillimeter: UnitMillimeter,
+		PrecipitationUnitInch:       UnitInch,
+	}
+)
+
+// ParseUnitSystem accepts "metric", "imperial" or a JSON object naming the
+// unit of every quantity, e.g. {"temperature": "celsius", "windSpeed": "kn",
+// "precipitation": "mm"}.
+func ParseUnitSystem(value string) (UnitSystem, error) {
+	switch strings.TrimSpace(value) {
+	case "metric":
+		return UnitSystemMetric, nil
+	case "imperial":
+		return UnitSystemImperial, nil
+	}
+
+	var system UnitSystem
+	if err := json.Unmarshal([]byte(value), &system); err != nil {
+		return UnitSystem{}, fmt.Errorf("unit system must be metric, imperial or a JSON object: %w", err)
+	}
+
+	if err := system.Validate(); err != nil {
+		return UnitSystem{}, err
+	}
+
+	return system, nil
+}
+
+func (s UnitSystem) Validate() error {
+	if _, ok := temperatureUnits[s.Temperature]; !ok {
+		return fmt.Errorf("unknown temperature unit %q", s.Temperature)
+	}
+
+	if _, ok := windSpeedUnits[s.WindSpeed]; !ok {
+		return fmt.Errorf("unknown wind speed unit %q", s.WindSpeed)
+	}
+
+	if _, ok := precipitationUnits[s.Precipitation]; !ok {
+		return fmt.Errorf("unknown precipitation unit %q", s.Precipitation)
+	}
+
+	return nil
+}
+
+func (s UnitSystem) TemperatureUnit() Unit {
+	return temperatureUnits[s.Temperature]
+}
+
+func (s UnitSystem) WindSpeedUnit() Unit {
+	return windSpeedUnits[s.WindSpeed]
+}
+
+func (s UnitSystem) PrecipitationUnit() Unit {
+	return precipitationUnits[s.Precipitation]
+}
+
+// VisibilityUnit follows the precipitation unit, a system measuring rain in
+// inches gets visibility in feet.
+func (s UnitSystem) VisibilityUnit() Unit {
+	if s.Precipitation == PrecipitationUnitInch {
+		return UnitFoot
+	}
+
+	return UnitMeter
+}
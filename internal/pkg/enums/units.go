@@ -1,10 +1,144 @@
 package enums
 
+import (
+	"fmt"
+	"math"
+)
+
+// Length is counted in micrometres, fine enough to keep sub-millimetre
+// precipitation in integer arithmetic.
 type Length int64
 
 const (
-	Millimeter Length = 1
+	Micrometer Length = 1
+	Millimeter        = 1000 * Micrometer
 	Centimeter        = 10 * Millimeter
 	Meter             = 100 * Centimeter
 	Kilometer         = 1000 * Meter
 )
+
+func Millimeters(v float64) Length {
+	return Length(math.Round(v * float64(Millimeter)))
+}
+
+func Meters(v float64) Length {
+	return Length(math.Round(v * float64(Meter)))
+}
+
+func (l Length) Millimeters() float64 {
+	return float64(l) / float64(Millimeter)
+}
+
+func (l Length) Meters() float64 {
+	return float64(l) / float64(Meter)
+}
+
+// In expresses the length in mm, m or one of their imperial counterparts.
+func (l Length) In(unit Unit) float64 {
+	if unit == UnitMeter || conversions[unit].canonical == UnitMeter {
+		return unit.FromCanonical(l.Meters())
+	}
+
+	return unit.FromCanonical(l.Millimeters())
+}
+
+// Speed is counted in kilometres per hour.
+type Speed float64
+
+const metersPerSecondInKilometersPerHour = 3.6
+
+func KilometersPerHour(v float64) Speed {
+	return Speed(v)
+}
+
+func MetersPerSecond(v float64) Speed {
+	return Speed(v * metersPerSecondInKilometersPerHour)
+}
+
+func (s Speed) KilometersPerHour() float64 {
+	return float64(s)
+}
+
+func (s Speed) In(unit Unit) float64 {
+	return unit.FromCanonical(float64(s))
+}
+
+// Temperature is counted in degrees Celsius.
+type Temperature float64
+
+func Celsius(v float64) Temperature {
+	return Temperature(v)
+}
+
+func (t Temperature) Celsius() float64 {
+	return float64(t)
+}
+
+func (t Temperature) In(unit Unit) float64 {
+	return unit.FromCanonical(float64(t))
+}
+
+// conversion links a unit to the canonical unit of its quantity: °C, km/h,
+// mm or m.
+type conversion struct {
+	canonical     Unit
+	toCanonical   func(float64) float64
+	fromCanonical func(float64) float64
+}
+
+var conversions = map[Unit]conversion{
+	UnitFahrenheit: {
+		canonical:     UnitCelsius,
+		toCanonical:   func(v float64) float64 { return (v - 32) * 5 / 9 },
+		fromCanonical: func(v float64) float64 { return v*9/5 + 32 },
+	},
+	UnitMetersPerSecond: {
+		canonical:     UnitKilometersPerHour,
+		toCanonical:   func(v float64) float64 { return v * metersPerSecondInKilometersPerHour },
+		fromCanonical: func(v float64) float64 { return v / metersPerSecondInKilometersPerHour },
+	},
+	UnitMilesPerHour: {
+		canonical:     UnitKilometersPerHour,
+		toCanonical:   func(v float64) float64 { return v * 1.609344 },
+		fromCanonical: func(v float64) float64 { return v / 1.609344 },
+	},
+	UnitKnots: {
+		canonical:     UnitKilometersPerHour,
+		toCanonical:   func(v float64) float64 { return v * 1.852 },
+		fromCanonical: func(v float64) float64 { return v / 1.852 },
+	},
+	UnitInch: {
+		canonical:     UnitMillimeter,
+		toCanonical:   func(v float64) float64 { return v * 25.4 },
+		fromCanonical: func(v float64) float64 { return v / 25.4 },
+	},
+	UnitFoot: {
+		canonical:     UnitMeter,
+		toCanonical:   func(v float64) float64 { return v * 0.3048 },
+		fromCanonical: func(v float64) float64 { return v / 0.3048 },
+	},
+}
+
+// ToCanonical converts a value reported in u into the canonical unit. Units
+// that already are canonical, as well as unitless values, pass through.
+func (u Unit) ToCanonical(value float64, canonical Unit) (float64, error) {
+	if u == "" || u == canonical {
+		return value, nil
+	}
+
+	c, ok := conversions[u]
+	if !ok || c.canonical != canonical {
+		return 0, fmt.Errorf("unable to convert %s to %s", u, canonical)
+	}
+
+	return c.toCanonical(value), nil
+}
+
+func (u Unit) FromCanonical(value float64) float64 {
+	c, ok := conversions[u]
+	if !ok {
+		return value
+	}
+
+	return c.fromCanonical(value)
+}
@@ -0,0 +1,85 @@
+package enums
+
+type (
+	Unit      string
+	ValueType int
+)
+
+const (
+	UnitCelsius           = Unit("°C")
+	UnitFahrenheit        = Unit("°F")
+	UnitPercent           = Unit("%")
+	UnitKilometersPerHour = Unit("km/h")
+	UnitMetersPerSecond   = Unit("m/s")
+	UnitMilesPerHour      = Unit("mph")
+	UnitKnots             = Unit("kn")
+	UnitMillimeter        = Unit("mm")
+	UnitInch              = Unit("inch")
+	UnitMeter             = Unit("m")
+	UnitFoot              = Unit("ft")
+	UnitWMOCode           = Unit("wmo code")
+)
+
+const (
+	ValueTypeFloat ValueType = iota
+	ValueTypeInteger
+)
+
+// Variable describes how a monitoring param is requested from Open-Meteo and
+// how its value has to be read. Unit is the unit the value is normalised to.
+type Variable struct {
+	Param MonitoringParam
+	Field string
+	Unit  Unit
+	Type  ValueType
+}
+
+var variables = map[MonitoringParam]Variable{
+	MonitoringParamTemperature: {
+		Param: MonitoringParamTemperature,
+		Field: "temperature_2m",
+		Unit:  UnitCelsius,
+		Type:  ValueTypeFloat,
+	},
+	MonitoringParamRelativeHumidity: {
+		Param: MonitoringParamRelativeHumidity,
+		Field: "relative_humidity_2m",
+		Unit:  UnitPercent,
+		Type:  ValueTypeInteger,
+	},
+	MonitoringParamWindSpeed: {
+		Param: MonitoringParamWindSpeed,
+		Field: "wind_speed_10m",
+		Unit:  UnitKilometersPerHour,
+		Type:  ValueTypeFloat,
+	},
+	MonitoringParamWeatherCode: {
+		Param: MonitoringParamWeatherCode,
+		Field: "weather_code",
+		Unit:  UnitWMOCode,
+		Type:  ValueTypeInteger,
+	},
+	MonitoringParamCloudCover: {
+		Param: MonitoringParamCloudCover,
+		Field: "cloud_cover",
+		Unit:  UnitPercent,
+		Type:  ValueTypeInteger,
+	},
+	MonitoringParamPrecipitation: {
+		Param: MonitoringParamPrecipitation,
+		Field: "precipitation",
+		Unit:  UnitMillimeter,
+		Type:  ValueTypeFloat,
+	},
+	MonitoringParamVisibility: {
+		Param: MonitoringParamVisibility,
+		Field: "visibility",
+		Unit:  UnitMeter,
+		Type:  ValueTypeFloat,
+	},
+}
+
+func LookupVariable(param MonitoringParam) (Variable, bool) {
+	v, ok := variables[param]
+	return v, ok
+}
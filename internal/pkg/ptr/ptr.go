@@ -0,0 +1,15 @@
+package ptr
+
+func To[T any](v T) *T {
+	return &v
+}
+
+// Map converts the value behind a pointer, keeping nil as nil. It maps
+// nil-able domain measurements to proto3 optional and JSON fields.
+func Map[V, T any](value *V, convert func(V) T) *T {
+	if value == nil {
+		return nil
+	}
+
+	return To(convert(*value))
+}
@@ -0,0 +1,34 @@
+package telemetry
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span attributes of the service that have no semantic convention.
+const (
+	CityKey         = attribute.Key("weather.city")
+	CityIDKey       = attribute.Key("weather.city_id")
+	CityCountKey    = attribute.Key("weather.city_count")
+	RowsAffectedKey = attribute.Key("db.response.affected_rows")
+)
+
+// Cities returns the attributes describing the cities a span works on.
+func Cities(names ...string) []attribute.KeyValue {
+	if len(names) == 1 {
+		return []attribute.KeyValue{CityKey.String(names[0])}
+	}
+
+	return []attribute.KeyValue{CityKey.StringSlice(names), CityCountKey.Int(len(names))}
+}
+
+// Fail marks the span as failed and returns err, so it can wrap a return.
+func Fail(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
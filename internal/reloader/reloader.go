@@ -0,0 +1,77 @@
+package reloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+)
+
+type target struct {
+	name    string
+	prepare func(ctx context.Context, provider config.Provider) (func(ctx context.Context), error)
+}
+
+var (
+	mu      sync.Mutex
+	targets []target
+)
+
+// Add registers a config that applies new values live. prepare validates
+// every value of provider without applying any of them and returns the
+// function that applies them all. It is only called once every registered
+// config accepted the file.
+func Add(name string, prepare func(ctx context.Context, provider config.Provider) (apply func(ctx context.Context), err error)) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	targets = append(targets, target{name: name, prepare: prepare})
+}
+
+// apply hands provider to every registered config. A single invalid value
+// keeps the previous values of all configs.
+func apply(ctx context.Context, provider config.Provider) error {
+	mu.Lock()
+	ts := make([]target, len(targets))
+	copy(ts, targets)
+	mu.Unlock()
+
+	applies := make([]func(ctx context.Context), 0, len(ts))
+	var errs []error
+	for _, t := range ts {
+		a, err := prepare(ctx, t, provider)
+		if err != nil {
+			logger.Error(ctx, "config reload rejected, previous values are kept", slog.String("config", t.name), slog.Any("error", err))
+			errs = append(errs, fmt.Errorf("%s: %w", t.name, err))
+			continue
+		}
+
+		applies = append(applies, a)
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	for _, a := range applies {
+		a(ctx)
+	}
+
+	return nil
+}
+
+// prepare runs a single config. The config library panics on a missing key,
+// which must not take the service down.
+func prepare(ctx context.Context, t target, provider config.Provider) (apply func(ctx context.Context), err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return t.prepare(ctx, provider)
+}
@@ -0,0 +1,134 @@
+package reloader_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/reloader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type metrics struct {
+	results map[string]int
+	mu      sync.Mutex
+}
+
+func (m *metrics) AddConfigReloadMetric(_ context.Context, result string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.results[result]++
+}
+
+func (m *metrics) count(result string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.results[result]
+}
+
+// TestWatcher registers a config the way the app does. The registry is
+// global, so the cases run one after another on the same watcher.
+func TestWatcher(t *testing.T) {
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	// The config library resolves the file and .env against the working
+	// directory.
+	dir := t.TempDir()
+	t.Chdir(dir)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".env"), nil, 0o600))
+
+	const path = "values.yaml"
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(content), 0o600))
+	}
+	values := func(workerPoolSize, batchSize int) string {
+		return fmt.Sprintf("worker_pool_size:\n  type: \"int\"\n  value: %d\nbatch_size:\n  type: \"int\"\n  value: %d\n", workerPoolSize, batchSize)
+	}
+	write(values(5, 2))
+
+	var workerPoolSize, batchSize atomic.Int64
+	workerPoolSize.Store(5)
+	batchSize.Store(2)
+	reloader.Add("worker pool", func(_ context.Context, provider config.Provider) (func(context.Context), error) {
+		size := provider.GetConfigClient().GetValue("worker_pool_size").Int()
+		if size < 1 {
+			return nil, errors.New("worker pool size value in config can not be less than 1")
+		}
+
+		return func(context.Context) { workerPoolSize.Store(int64(size)) }, nil
+	})
+	reloader.Add("batch", func(_ context.Context, provider config.Provider) (func(context.Context), error) {
+		size := provider.GetConfigClient().GetValue("batch_size").Int()
+		if size < 1 {
+			return nil, errors.New("batch size value in config can not be less than 1")
+		}
+
+		return func(context.Context) { batchSize.Store(int64(size)) }, nil
+	})
+
+	m := &metrics{results: make(map[string]int)}
+	watcher := reloader.NewWatcher(path, 10*time.Millisecond, m)
+	require.NoError(t, watcher.Start(context.Background()))
+	defer func() {
+		require.NoError(t, watcher.Stop(context.Background()))
+	}()
+
+	t.Run("unchanged file is not reloaded", func(t *testing.T) {
+		time.Sleep(50 * time.Millisecond)
+		assert.Zero(t, m.count(reloader.ResultApplied))
+		assert.Zero(t, m.count(reloader.ResultRejected))
+	})
+
+	t.Run("changed file is applied", func(t *testing.T) {
+		write(values(7, 3))
+
+		require.Eventually(t, func() bool { return m.count(reloader.ResultApplied) == 1 }, time.Second, 5*time.Millisecond)
+		assert.EqualValues(t, 7, workerPoolSize.Load())
+		assert.EqualValues(t, 3, batchSize.Load())
+	})
+
+	t.Run("invalid value keeps every previous value", func(t *testing.T) {
+		write(values(0, 4))
+
+		require.Eventually(t, func() bool { return m.count(reloader.ResultRejected) == 1 }, time.Second, 5*time.Millisecond)
+		assert.EqualValues(t, 7, workerPoolSize.Load())
+		assert.EqualValues(t, 3, batchSize.Load())
+	})
+
+	t.Run("malformed file is rejected", func(t *testing.T) {
+		write("worker_pool_size: [")
+
+		require.Eventually(t, func() bool { return m.count(reloader.ResultRejected) == 2 }, time.Second, 5*time.Millisecond)
+		assert.EqualValues(t, 7, workerPoolSize.Load())
+	})
+
+	t.Run("missing key is rejected", func(t *testing.T) {
+		write("batch_size:\n  type: \"int\"\n  value: 5\n")
+
+		require.Eventually(t, func() bool { return m.count(reloader.ResultRejected) == 3 }, time.Second, 5*time.Millisecond)
+		assert.EqualValues(t, 7, workerPoolSize.Load())
+		assert.EqualValues(t, 3, batchSize.Load())
+	})
+
+	t.Run("explicit reload", func(t *testing.T) {
+		write(values(9, 4))
+
+		// The poll may apply the file first, either way it ends up applied.
+		_ = watcher.Reload(context.Background())
+		assert.EqualValues(t, 9, workerPoolSize.Load())
+		assert.EqualValues(t, 4, batchSize.Load())
+		assert.GreaterOrEqual(t, m.count(reloader.ResultApplied), 2)
+	})
+}
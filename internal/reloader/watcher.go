@@ -0,0 +1,178 @@
+package reloader
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+)
+
+const (
+	ResultApplied  = "applied"
+	ResultRejected = "rejected"
+)
+
+type Metrics interface {
+	AddConfigReloadMetric(ctx context.Context, result string)
+}
+
+// Watcher reloads the config file when its content changes or the process
+// gets SIGHUP. The file is polled rather than watched for events, which also
+// follows the symlink swaps of mounted config maps.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	metrics  Metrics
+
+	checksum []byte
+	mu       sync.Mutex
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewWatcher watches path, relative to the working directory as
+// config.NewProvider expects it. A zero interval turns polling off, the file
+// is then only reloaded on SIGHUP.
+func NewWatcher(path string, interval time.Duration, metrics Metrics) *Watcher {
+	return &Watcher{
+		path:     path,
+		interval: interval,
+		metrics:  metrics,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+func (w *Watcher) Start(ctx context.Context) error {
+	checksum, err := w.read()
+	if err != nil {
+		return err
+	}
+	w.checksum = checksum
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	var (
+		ticker *time.Ticker
+		tick   <-chan time.Time
+	)
+	if w.interval > 0 {
+		ticker = time.NewTicker(w.interval)
+		tick = ticker.C
+	}
+
+	go func() {
+		defer close(w.done)
+		defer signal.Stop(signals)
+		if ticker != nil {
+			defer ticker.Stop()
+		}
+
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-signals:
+				logger.Info(ctx, "reloading config on SIGHUP")
+				_ = w.Reload(ctx)
+			case <-tick:
+				if w.changed() {
+					logger.Info(ctx, "reloading changed config", slog.String("path", w.path))
+					_ = w.Reload(ctx)
+				}
+			}
+		}
+	}()
+
+	logger.Info(ctx, fmt.Sprintf("[%T.Start] config watcher started", w), slog.String("path", w.path), slog.String("interval", w.interval.String()))
+	return nil
+}
+
+// Reload reads the file and applies it to the registered configs. Nothing
+// is applied when any value is rejected.
+func (w *Watcher) Reload(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if checksum, err := w.read(); err == nil {
+		w.checksum = checksum
+	}
+
+	err := w.apply(ctx)
+	if err != nil {
+		w.metrics.AddConfigReloadMetric(ctx, ResultRejected)
+		return err
+	}
+
+	w.metrics.AddConfigReloadMetric(ctx, ResultApplied)
+	logger.Info(ctx, "config reloaded", slog.String("path", w.path))
+	return nil
+}
+
+func (w *Watcher) Stop(ctx context.Context) error {
+	close(w.stop)
+
+	select {
+	case <-w.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func (w *Watcher) apply(ctx context.Context) error {
+	provider, err := load(w.path)
+	if err != nil {
+		logger.Error(ctx, "config reload rejected, unable to read the file", slog.String("path", w.path), slog.Any("error", err))
+		return err
+	}
+
+	return apply(ctx, provider)
+}
+
+func (w *Watcher) changed() bool {
+	checksum, err := w.read()
+	if err != nil {
+		return false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return !bytes.Equal(checksum, w.checksum)
+}
+
+func (w *Watcher) read() ([]byte, error) {
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+// load parses the file the way the service did at start, .env included.
+// config.NewProvider panics on a malformed file, a reload turns that into an
+// error.
+func load(path string) (provider config.Provider, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return config.NewProvider(path), nil
+}
@@ -0,0 +1,88 @@
+package weather_repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/alert_service"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+func (r *Repository) GetRuleStates(ctx context.Context) (alert_service.RuleStates, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.GetRuleStates]", r), "SELECT", "alert_rule_states")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Select("rule_name", "city_id", "raised", "matches", "misses", "captured_at").
+		From("alert_rule_states")
+
+	rows, err := qb.RunWith(r.db).QueryContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetRuleStates] QueryContext error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+	defer rows.Close()
+
+	var states alert_service.RuleStates
+	for rows.Next() {
+		var state alert_service.RuleState
+		if err := rows.Scan(&state.Rule, &state.CityID, &state.Raised, &state.Matches, &state.Misses, &state.CapturedAt); err != nil {
+			logger.Error(ctx, fmt.Sprintf("[%T.GetRuleStates] Scan error", r), slog.Any("error", err))
+			return nil, telemetry.Fail(span, err)
+		}
+
+		state.CapturedAt = state.CapturedAt.UTC()
+		states = append(states, state)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetRuleStates] Rows error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+
+	span.SetAttributes(semconv.DBResponseReturnedRows(len(states)))
+	return states, nil
+}
+
+// SaveRuleStates upserts the states. A state older than the stored one is
+// skipped, evaluations finishing out of order do not move a rule back.
+func (r *Repository) SaveRuleStates(ctx context.Context, states alert_service.RuleStates) error {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.SaveRuleStates]", r), "INSERT", "alert_rule_states")
+	defer span.End()
+
+	if len(states) == 0 {
+		return nil
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Insert("alert_rule_states").
+		Columns("rule_name", "city_id", "raised", "matches", "misses", "captured_at")
+
+	for _, state := range states {
+		qb = qb.Values(state.Rule, state.CityID, state.Raised, state.Matches, state.Misses, state.CapturedAt.UTC())
+	}
+
+	qb = qb.Suffix(`ON CONFLICT (rule_name, city_id) DO UPDATE SET
+		raised = EXCLUDED.raised,
+		matches = EXCLUDED.matches,
+		misses = EXCLUDED.misses,
+		captured_at = EXCLUDED.captured_at
+		WHERE alert_rule_states.captured_at < EXCLUDED.captured_at`)
+
+	result, err := qb.RunWith(r.db).ExecContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SaveRuleStates] unable to ExecContext", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+	rowsAffected(span, result)
+
+	return nil
+}
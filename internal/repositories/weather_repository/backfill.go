@@ -0,0 +1,111 @@
+package weather_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/backfill_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+func (r *Repository) GetBackfillProgress(ctx context.Context, cityID int64, from, to time.Time) (backfill_service.Progress, bool, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.GetBackfillProgress]", r), "SELECT", "backfill_progress")
+	defer span.End()
+
+	span.SetAttributes(telemetry.CityIDKey.Int64(cityID))
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Select("next_date").
+		From("backfill_progress").
+		Where(sq.Eq{
+			"city_id":    cityID,
+			"start_date": from,
+			"end_date":   to,
+		})
+
+	var nextDate time.Time
+	if err := qb.RunWith(r.db).QueryRowContext(ctx).Scan(&nextDate); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return backfill_service.Progress{}, false, nil
+		}
+
+		logger.Error(ctx, fmt.Sprintf("[%T.GetBackfillProgress] QueryRowContext error", r), slog.Any("error", err))
+		return backfill_service.Progress{}, false, telemetry.Fail(span, err)
+	}
+
+	return backfill_service.Progress{
+		CityID:   cityID,
+		From:     from,
+		To:       to,
+		NextDate: nextDate,
+	}, true, nil
+}
+
+func (r *Repository) SaveBackfillChunk(ctx context.Context, progress backfill_service.Progress, conditions weather_service.CityWeatherConditions) error {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.SaveBackfillChunk]", r), "INSERT", "backfill_progress")
+	defer span.End()
+
+	span.SetAttributes(telemetry.CityIDKey.Int64(progress.CityID))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SaveBackfillChunk] unable to BeginTx", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+	defer tx.Rollback()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	if len(conditions) > 0 {
+		inserted, err := r.appendObservations(ctx, tx, conditions, false)
+		if err != nil {
+			logger.Error(ctx, fmt.Sprintf("[%T.SaveBackfillChunk] unable to append observations", r), slog.Any("error", err))
+			return telemetry.Fail(span, err)
+		}
+		span.SetAttributes(telemetry.RowsAffectedKey.Int64(inserted))
+	}
+
+	progressQb := psql.
+		Insert("backfill_progress").
+		Columns(
+			"city_id",
+			"start_date",
+			"end_date",
+			"next_date",
+			"updated_at",
+		).
+		Values(
+			progress.CityID,
+			progress.From,
+			progress.To,
+			progress.NextDate,
+			time.Now().UTC(),
+		).
+		Suffix(`
+			ON CONFLICT (city_id, start_date, end_date)
+			DO UPDATE SET
+				next_date  = EXCLUDED.next_date,
+				updated_at = EXCLUDED.updated_at;
+		`)
+
+	if _, err := progressQb.RunWith(tx).ExecContext(ctx); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SaveBackfillChunk] unable to save progress", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SaveBackfillChunk] unable to Commit", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,280 @@
+package weather_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/city_service"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+// uniqueViolation is the Postgres error code of a unique constraint
+// violation.
+const uniqueViolation = pq.ErrorCode("23505")
+
+var cityColumns = []string{
+	"id",
+	"name",
+	"country",
+	"latitude",
+	"longitude",
+	"enabled",
+	"tags",
+	"created_at",
+	"updated_at",
+}
+
+// ListCities returns every city, the disabled ones included, sorted by name
+// and country.
+func (r *Repository) ListCities(ctx context.Context) (city_service.Cities, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.ListCities]", r), "SELECT", "cities")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Select(cityColumns...).
+		From("cities").
+		OrderBy("name", "country")
+
+	rows, err := qb.RunWith(r.db).QueryContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.ListCities] QueryContext error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+	defer rows.Close()
+
+	cities := make(city_service.Cities, 0)
+	for rows.Next() {
+		city, err := scanCity(rows)
+		if err != nil {
+			logger.Error(ctx, fmt.Sprintf("[%T.ListCities] Scan error", r), slog.Any("error", err))
+			return nil, telemetry.Fail(span, err)
+		}
+
+		cities = append(cities, city)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.ListCities] Rows error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+
+	span.SetAttributes(semconv.DBResponseReturnedRows(len(cities)))
+	return cities, nil
+}
+
+func (r *Repository) CreateCity(ctx context.Context, city city_service.City) (city_service.City, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.CreateCity]", r), "INSERT", "cities")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Insert("cities").
+		Columns("name", "country", "latitude", "longitude", "enabled", "tags").
+		Values(city.Name, city.Country, city.Lat, city.Long, city.Enabled, pq.Array(tags(city.Tags))).
+		Suffix("RETURNING " + strings.Join(cityColumns, ", "))
+
+	created, err := scanCity(qb.RunWith(r.db).QueryRowContext(ctx))
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.CreateCity] unable to insert city", r), slog.Any("error", err))
+		return city_service.City{}, telemetry.Fail(span, cityError(err))
+	}
+
+	return created, nil
+}
+
+func (r *Repository) UpdateCity(ctx context.Context, city city_service.City) (city_service.City, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.UpdateCity]", r), "UPDATE", "cities")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Update("cities").
+		Set("name", city.Name).
+		Set("country", city.Country).
+		Set("latitude", city.Lat).
+		Set("longitude", city.Long).
+		Set("enabled", city.Enabled).
+		Set("tags", pq.Array(tags(city.Tags))).
+		Set("updated_at", time.Now().UTC()).
+		Where(sq.Eq{"id": city.ID}).
+		Suffix("RETURNING " + strings.Join(cityColumns, ", "))
+
+	updated, err := scanCity(qb.RunWith(r.db).QueryRowContext(ctx))
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.UpdateCity] unable to update city", r), slog.Any("error", err))
+		return city_service.City{}, telemetry.Fail(span, cityError(err))
+	}
+
+	return updated, nil
+}
+
+func (r *Repository) SetCityEnabled(ctx context.Context, id int64, enabled bool) (city_service.City, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.SetCityEnabled]", r), "UPDATE", "cities")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Update("cities").
+		Set("enabled", enabled).
+		Set("updated_at", time.Now().UTC()).
+		Where(sq.Eq{"id": id}).
+		Suffix("RETURNING " + strings.Join(cityColumns, ", "))
+
+	updated, err := scanCity(qb.RunWith(r.db).QueryRowContext(ctx))
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SetCityEnabled] unable to update city", r), slog.Any("error", err))
+		return city_service.City{}, telemetry.Fail(span, cityError(err))
+	}
+
+	return updated, nil
+}
+
+func (r *Repository) DeleteCity(ctx context.Context, id int64) error {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.DeleteCity]", r), "DELETE", "cities")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Delete("cities").
+		Where(sq.Eq{"id": id})
+
+	result, err := qb.RunWith(r.db).ExecContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.DeleteCity] unable to ExecContext", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return telemetry.Fail(span, err)
+	}
+
+	if deleted == 0 {
+		return telemetry.Fail(span, city_service.ErrCityNotFound)
+	}
+
+	return nil
+}
+
+// SeedCities inserts cities only while the table is empty and returns the
+// number of inserted cities. The table is locked so that instances starting
+// together seed it once.
+func (r *Repository) SeedCities(ctx context.Context, cities city_service.Cities) (int64, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.SeedCities]", r), "INSERT", "cities")
+	defer span.End()
+
+	if len(cities) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SeedCities] unable to BeginTx", r), slog.Any("error", err))
+		return 0, telemetry.Fail(span, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "LOCK TABLE cities IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SeedCities] unable to lock cities", r), slog.Any("error", err))
+		return 0, telemetry.Fail(span, err)
+	}
+
+	var seeded bool
+	if err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM cities)").Scan(&seeded); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SeedCities] unable to check cities", r), slog.Any("error", err))
+		return 0, telemetry.Fail(span, err)
+	}
+
+	if seeded {
+		return 0, nil
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Insert("cities").
+		Columns("name", "country", "latitude", "longitude", "enabled", "tags")
+
+	for _, city := range cities {
+		qb = qb.Values(city.Name, city.Country, city.Lat, city.Long, city.Enabled, pq.Array(tags(city.Tags)))
+	}
+
+	result, err := qb.RunWith(tx).ExecContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SeedCities] unable to ExecContext", r), slog.Any("error", err))
+		return 0, telemetry.Fail(span, cityError(err))
+	}
+	rowsAffected(span, result)
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SeedCities] unable to Commit", r), slog.Any("error", err))
+		return 0, telemetry.Fail(span, err)
+	}
+
+	return result.RowsAffected()
+}
+
+func scanCity(row sq.RowScanner) (city_service.City, error) {
+	var (
+		city     city_service.City
+		cityTags []string
+	)
+
+	if err := row.Scan(
+		&city.ID,
+		&city.Name,
+		&city.Country,
+		&city.Lat,
+		&city.Long,
+		&city.Enabled,
+		pq.Array(&cityTags),
+		&city.CreatedAt,
+		&city.UpdatedAt,
+	); err != nil {
+		return city_service.City{}, err
+	}
+
+	city.Tags = tags(cityTags)
+	city.CreatedAt = city.CreatedAt.UTC()
+	city.UpdatedAt = city.UpdatedAt.UTC()
+	return city, nil
+}
+
+// tags keeps an empty list non-nil, the column is NOT NULL.
+func tags(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+// cityError maps the database errors a client can cause to the errors of
+// city_service.
+func cityError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return city_service.ErrCityNotFound
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s", city_service.ErrCityExists, pqErr.Detail)
+	}
+
+	return err
+}
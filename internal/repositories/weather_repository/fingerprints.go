@@ -0,0 +1,84 @@
+package weather_repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+func (r *Repository) GetPublishedFingerprints(ctx context.Context) (weather_service.Fingerprints, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.GetPublishedFingerprints]", r), "SELECT", "published_condition_fingerprints")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Select("city_id", "fingerprint").
+		From("published_condition_fingerprints")
+
+	rows, err := qb.RunWith(r.db).QueryContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetPublishedFingerprints] QueryContext error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+	defer rows.Close()
+
+	fingerprints := make(weather_service.Fingerprints)
+	for rows.Next() {
+		var (
+			cityID      int64
+			fingerprint string
+		)
+		if err := rows.Scan(&cityID, &fingerprint); err != nil {
+			logger.Error(ctx, fmt.Sprintf("[%T.GetPublishedFingerprints] Scan error", r), slog.Any("error", err))
+			return nil, telemetry.Fail(span, err)
+		}
+
+		fingerprints[cityID] = fingerprint
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetPublishedFingerprints] Rows error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+
+	span.SetAttributes(semconv.DBResponseReturnedRows(len(fingerprints)))
+	return fingerprints, nil
+}
+
+func (r *Repository) SavePublishedFingerprints(ctx context.Context, fingerprints weather_service.Fingerprints) error {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.SavePublishedFingerprints]", r), "INSERT", "published_condition_fingerprints")
+	defer span.End()
+
+	if len(fingerprints) == 0 {
+		return nil
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	now := time.Now().UTC()
+	qb := psql.
+		Insert("published_condition_fingerprints").
+		Columns("city_id", "fingerprint", "published_at")
+
+	for cityID, fingerprint := range fingerprints {
+		qb = qb.Values(cityID, fingerprint, now)
+	}
+
+	qb = qb.Suffix("ON CONFLICT (city_id) DO UPDATE SET fingerprint = EXCLUDED.fingerprint, published_at = EXCLUDED.published_at")
+	result, err := qb.RunWith(r.db).ExecContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SavePublishedFingerprints] unable to ExecContext", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+	rowsAffected(span, result)
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package weather_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+func (r *Repository) SaveForecasts(ctx context.Context, forecasts weather_service.CityWeatherForecasts) error {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.SaveForecasts]", r), "INSERT", "weather_forecasts")
+	defer span.End()
+
+	if len(forecasts) == 0 {
+		return nil
+	}
+
+	span.SetAttributes(telemetry.CityCountKey.Int(len(forecasts)))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SaveForecasts] unable to BeginTx", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+	defer tx.Rollback()
+
+	for _, forecast := range forecasts {
+		if err := r.saveForecast(ctx, tx, forecast); err != nil {
+			logger.Error(ctx, fmt.Sprintf("[%T.SaveForecasts] unable to save forecast", r), slog.String("city", forecast.City.Name), slog.Any("error", err))
+			return telemetry.Fail(span, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SaveForecasts] unable to Commit", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) saveForecast(ctx context.Context, tx *sql.Tx, forecast weather_service.CityWeatherForecast) error {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	headerQb := psql.
+		Insert("weather_forecasts").
+		Columns(
+			"city_id",
+			"city_name",
+			"latitude",
+			"longitude",
+			"captured_at",
+		).
+		Values(
+			forecast.City.ID,
+			forecast.City.Name,
+			forecast.City.Coordinates.Lat,
+			forecast.City.Coordinates.Long,
+			forecast.CapturedAt,
+		).
+		Suffix(`
+			ON CONFLICT (city_id)
+			DO UPDATE SET
+				city_name   = EXCLUDED.city_name,
+				latitude    = EXCLUDED.latitude,
+				longitude   = EXCLUDED.longitude,
+				captured_at = EXCLUDED.captured_at;
+		`)
+
+	if _, err := headerQb.RunWith(tx).ExecContext(ctx); err != nil {
+		return err
+	}
+
+	for _, table := range []string{"hourly_weather_forecasts", "daily_weather_forecasts"} {
+		deleteQb := psql.Delete(table).Where(sq.Eq{"city_id": forecast.City.ID})
+		if _, err := deleteQb.RunWith(tx).ExecContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(forecast.Hourly) > 0 {
+		hourlyQb := psql.
+			Insert("hourly_weather_forecasts").
+			Columns(
+				"city_id",
+				"forecast_time",
+				"temperature",
+				"relative_humidity_percent",
+				"wind_speed",
+				"weather_code",
+				"cloud_cover_percent",
+				"precipitation_millimeters",
+				"visibility_millimeters",
+			)
+
+		for _, hourly := range forecast.Hourly {
+			hourlyQb = hourlyQb.Values(
+				forecast.City.ID,
+				hourly.Time,
+				hourly.Temperature,
+				hourly.RelativeHumidityPercent,
+				hourly.WindSpeed,
+				hourly.WeatherCode,
+				hourly.CloudCoverPercent,
+				millimeters(hourly.Precipitation),
+				millimeters(hourly.Visibility),
+			)
+		}
+
+		if _, err := hourlyQb.RunWith(tx).ExecContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(forecast.Daily) > 0 {
+		dailyQb := psql.
+			Insert("daily_weather_forecasts").
+			Columns(
+				"city_id",
+				"forecast_date",
+				"weather_code",
+				"temperature_max",
+				"temperature_min",
+				"precipitation_sum_millimeters",
+				"wind_speed_max",
+			)
+
+		for _, daily := range forecast.Daily {
+			dailyQb = dailyQb.Values(
+				forecast.City.ID,
+				daily.Date,
+				daily.WeatherCode,
+				daily.TemperatureMax,
+				daily.TemperatureMin,
+				millimeters(daily.PrecipitationSum),
+				daily.WindSpeedMax,
+			)
+		}
+
+		if _, err := dailyQb.RunWith(tx).ExecContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
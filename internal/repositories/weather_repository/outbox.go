@@ -0,0 +1,140 @@
+package weather_repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+func (r *Repository) GetPendingOutbox(ctx context.Context, limit int) (weather_service.OutboxEntries, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.GetPendingOutbox]", r), "SELECT", "weather_conditions_outbox")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := selectConditions(psql, "weather_observations", "o.id", "o.attempts").
+		Join("weather_conditions_outbox o ON o.city_id = w.city_id AND o.captured_at = w.captured_at").
+		Where(sq.Eq{"o.published_at": nil, "o.parked_at": nil}).
+		OrderBy("o.id").
+		Limit(uint64(limit))
+
+	rows, err := qb.RunWith(r.db).QueryContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetPendingOutbox] QueryContext error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+	defer rows.Close()
+
+	var entries weather_service.OutboxEntries
+	for rows.Next() {
+		var (
+			entry weather_service.OutboxEntry
+			ic    conditionRow
+		)
+
+		dest := append([]any{&entry.ID, &entry.Attempts}, ic.dest()...)
+		if err := rows.Scan(dest...); err != nil {
+			logger.Error(ctx, fmt.Sprintf("[%T.GetPendingOutbox] Scan error", r), slog.Any("error", err))
+			return nil, telemetry.Fail(span, err)
+		}
+
+		entry.Condition = ic.condition()
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetPendingOutbox] Rows error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+
+	span.SetAttributes(semconv.DBResponseReturnedRows(len(entries)))
+	return entries, nil
+}
+
+func (r *Repository) MarkOutboxPublished(ctx context.Context, ids []int64) error {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.MarkOutboxPublished]", r), "UPDATE", "weather_conditions_outbox")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Update("weather_conditions_outbox").
+		Set("published_at", time.Now().UTC()).
+		Set("last_error", nil).
+		Where(sq.Eq{"id": ids})
+
+	result, err := qb.RunWith(r.db).ExecContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.MarkOutboxPublished] unable to ExecContext", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+	rowsAffected(span, result)
+
+	return nil
+}
+
+func (r *Repository) MarkOutboxFailed(ctx context.Context, ids []int64, reason string) error {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.MarkOutboxFailed]", r), "UPDATE", "weather_conditions_outbox")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Update("weather_conditions_outbox").
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("last_error", reason).
+		Where(sq.Eq{"id": ids})
+
+	result, err := qb.RunWith(r.db).ExecContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.MarkOutboxFailed] unable to ExecContext", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+	rowsAffected(span, result)
+
+	return nil
+}
+
+// ParkOutbox takes entries out of the pending ones after their last failed
+// attempt. Parked entries are kept with their error for inspection.
+func (r *Repository) ParkOutbox(ctx context.Context, ids []int64, reason string) error {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.ParkOutbox]", r), "UPDATE", "weather_conditions_outbox")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := psql.
+		Update("weather_conditions_outbox").
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("last_error", reason).
+		Set("parked_at", time.Now().UTC()).
+		Where(sq.Eq{"id": ids})
+
+	result, err := qb.RunWith(r.db).ExecContext(ctx)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.ParkOutbox] unable to ExecContext", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+	rowsAffected(span, result)
+
+	return nil
+}
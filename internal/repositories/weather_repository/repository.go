@@ -10,8 +10,10 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/meteogo/logger/pkg/logger"
 	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
 	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
-	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 
 	_ "github.com/lib/pq"
 )
@@ -26,30 +28,84 @@ func NewRepository(db *sql.DB) *Repository {
 	}
 }
 
+// Ping checks that the database accepts connections.
+func (r *Repository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+var conditionColumns = []string{
+	"city_id",
+	"city_name",
+	"latitude",
+	"longitude",
+	"captured_at",
+	"temperature",
+	"relative_humidity_percent",
+	"wind_speed",
+	"weather_code",
+	"cloud_cover_percent",
+	"precipitation_millimeters",
+	"visibility_millimeters",
+	"provider",
+}
+
 func (r *Repository) SaveConditions(ctx context.Context, conditions weather_service.CityWeatherConditions) error {
-	_, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.SaveConditions]", r))
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.SaveConditions]", r), "INSERT", "weather_observations")
 	defer span.End()
 
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SaveConditions] unable to BeginTx", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+	defer tx.Rollback()
+
+	inserted, err := r.appendObservations(ctx, tx, conditions, true)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SaveConditions] unable to append observations", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+	span.SetAttributes(telemetry.RowsAffectedKey.Int64(inserted))
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.SaveConditions] unable to Commit", r), slog.Any("error", err))
+		return telemetry.Fail(span, err)
+	}
+
+	return nil
+}
+
+// appendObservations inserts conditions into the monthly partitions of
+// weather_observations, creating missing partitions first. A reading that is
+// already stored for the same city and capture time is left untouched. With
+// enqueue set, every newly inserted reading also gets an outbox entry. The
+// number of inserted readings is returned.
+func (r *Repository) appendObservations(ctx context.Context, tx *sql.Tx, conditions weather_service.CityWeatherConditions, enqueue bool) (int64, error) {
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
+	months := make(map[time.Time]struct{})
+	for _, condition := range conditions {
+		capturedAt := condition.CapturedAt
+		months[time.Date(capturedAt.Year(), capturedAt.Month(), 1, 0, 0, 0, 0, time.UTC)] = struct{}{}
+	}
+
+	for month := range months {
+		if _, err := tx.ExecContext(ctx, "SELECT ensure_weather_observations_partition($1)", month); err != nil {
+			return 0, err
+		}
+	}
+
 	qb := psql.
-		Insert("current_weather_conditions").
-		Columns(
-			"city_name",
-			"latitude",
-			"longitude",
-			"captured_at",
-			"temperature",
-			"relative_humidity_percent",
-			"wind_speed",
-			"weather_code",
-			"cloud_cover_percent",
-			"precipitation_millimeters",
-			"visibility_millimeters",
-		)
+		Insert("weather_observations").
+		Columns(conditionColumns...)
 
 	for _, condition := range conditions {
 		qb = qb.Values(
+			condition.City.ID,
 			condition.City.Name,
 			condition.City.Coordinates.Lat,
 			condition.City.Coordinates.Long,
@@ -59,122 +115,250 @@ func (r *Repository) SaveConditions(ctx context.Context, conditions weather_serv
 			condition.WindSpeed,
 			condition.WeatherCode,
 			condition.CloudCoverPercent,
-			condition.Precipitation,
-			condition.Visibility,
+			millimeters(condition.Precipitation),
+			millimeters(condition.Visibility),
+			condition.Provider,
 		)
 	}
 
-	qb = qb.Suffix(`
-		ON CONFLICT (city_name)
-		DO UPDATE SET
-			latitude                  = EXCLUDED.latitude,
-    		longitude                 = EXCLUDED.longitude,
-    		captured_at               = EXCLUDED.captured_at,
-			temperature               = EXCLUDED.temperature,
-			relative_humidity_percent = EXCLUDED.relative_humidity_percent,
-			wind_speed                = EXCLUDED.wind_speed,
-			weather_code              = EXCLUDED.weather_code,
-			cloud_cover_percent       = EXCLUDED.cloud_cover_percent,
-			precipitation_millimeters = EXCLUDED.precipitation_millimeters,
-			visibility_millimeters    = EXCLUDED.visibility_millimeters;
-	`)
+	qb = qb.Suffix("ON CONFLICT (city_id, captured_at) DO NOTHING")
+	if !enqueue {
+		result, err := qb.RunWith(tx).ExecContext(ctx)
+		if err != nil {
+			return 0, err
+		}
 
-	if _, err := qb.RunWith(r.db).ExecContext(ctx); err != nil {
-		logger.Error(ctx, fmt.Sprintf("[%T.Save] unable to ExecContext", r), slog.Any("error", err))
-		return err
+		return result.RowsAffected()
 	}
 
-	return nil
+	insertSQL, args, err := qb.Suffix("RETURNING city_id, captured_at").ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		WITH inserted AS (%s)
+		INSERT INTO weather_conditions_outbox (city_id, captured_at)
+		SELECT city_id, captured_at FROM inserted
+	`, insertSQL), args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
 }
 
 func (r *Repository) GetConditions(ctx context.Context) (weather_service.CityWeatherConditions, error) {
-	_, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.GetConditions]", r))
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.GetConditions]", r), "SELECT", "current_weather_conditions")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := selectConditions(psql, "current_weather_conditions")
+
+	conditions, err := r.queryConditions(ctx, qb)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetConditions] query error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+
+	span.SetAttributes(semconv.DBResponseReturnedRows(len(conditions)))
+	return conditions, nil
+}
+
+// GetConditionsPage returns the current conditions of the cities with an id
+// above afterCityID, ordered by id, at most limit of them.
+func (r *Repository) GetConditionsPage(ctx context.Context, afterCityID int64, limit int) (weather_service.CityWeatherConditions, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.GetConditionsPage]", r), "SELECT", "current_weather_conditions")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := selectConditions(psql, "current_weather_conditions").
+		Where(sq.Gt{"w.city_id": afterCityID}).
+		OrderBy("w.city_id").
+		Limit(uint64(limit))
+
+	conditions, err := r.queryConditions(ctx, qb)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetConditionsPage] query error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+
+	span.SetAttributes(semconv.DBResponseReturnedRows(len(conditions)))
+	return conditions, nil
+}
+
+// GetCityCondition returns the current condition of a city, false when the
+// city has no observations.
+func (r *Repository) GetCityCondition(ctx context.Context, cityID int64) (weather_service.CityWeatherCondition, bool, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.GetCityCondition]", r), "SELECT", "current_weather_conditions")
+	defer span.End()
+
+	span.SetAttributes(telemetry.CityIDKey.Int64(cityID))
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	qb := selectConditions(psql, "current_weather_conditions").
+		Where(sq.Eq{"w.city_id": cityID})
+
+	conditions, err := r.queryConditions(ctx, qb)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetCityCondition] query error", r), slog.Any("error", err))
+		return weather_service.CityWeatherCondition{}, false, telemetry.Fail(span, err)
+	}
+
+	span.SetAttributes(semconv.DBResponseReturnedRows(len(conditions)))
+	if len(conditions) == 0 {
+		return weather_service.CityWeatherCondition{}, false, nil
+	}
+
+	return conditions[0], true, nil
+}
+
+// GetConditionsHistory returns the observations of a city captured within
+// [from, to), oldest first. A positive limit caps the number of rows.
+func (r *Repository) GetConditionsHistory(ctx context.Context, cityID int64, from, to time.Time, limit int) (weather_service.CityWeatherConditions, error) {
+	ctx, span := startSpan(ctx, fmt.Sprintf("[%T.GetConditionsHistory]", r), "SELECT", "weather_observations")
 	defer span.End()
 
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
-	qb := psql.Select(
-		"city_name",
-		"latitude",
-		"longitude",
-		"captured_at",
-		"temperature",
-		"relative_humidity_percent",
-		"wind_speed",
-		"weather_code",
-		"cloud_cover_percent",
-		"precipitation_millimeters",
-		"visibility_millimeters",
-	).
-		From("current_weather_conditions")
+	qb := selectConditions(psql, "weather_observations").
+		Where(sq.Eq{"w.city_id": cityID}).
+		Where(sq.GtOrEq{"w.captured_at": from}).
+		Where(sq.Lt{"w.captured_at": to}).
+		OrderBy("w.captured_at")
+
+	if limit > 0 {
+		qb = qb.Limit(uint64(limit))
+	}
+
+	conditions, err := r.queryConditions(ctx, qb)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("[%T.GetConditionsHistory] query error", r), slog.Any("error", err))
+		return nil, telemetry.Fail(span, err)
+	}
+
+	span.SetAttributes(telemetry.CityIDKey.Int64(cityID), semconv.DBResponseReturnedRows(len(conditions)))
+	return conditions, nil
+}
+
+// selectConditions reads conditionColumns from table, aliased w. The name
+// comes from the cities table, so a renamed city reads under its current
+// name. Readings of a deleted city keep the name they were stored with.
+func selectConditions(psql sq.StatementBuilderType, table string, columns ...string) sq.SelectBuilder {
+	for _, column := range conditionColumns {
+		if column == "city_name" {
+			columns = append(columns, "COALESCE(c.name, w.city_name)")
+			continue
+		}
+
+		columns = append(columns, "w."+column)
+	}
+
+	return psql.
+		Select(columns...).
+		From(table + " w").
+		LeftJoin("cities c ON c.id = w.city_id")
+}
 
+func (r *Repository) queryConditions(ctx context.Context, qb sq.SelectBuilder) (weather_service.CityWeatherConditions, error) {
 	rows, err := qb.RunWith(r.db).QueryContext(ctx)
 	if err != nil {
-		logger.Error(ctx, fmt.Sprintf("[%T.GetConditions] QueryContext error", r), slog.Any("error", err))
 		return nil, err
 	}
 	defer rows.Close()
 
 	var conditions weather_service.CityWeatherConditions
-
-	type intermediate struct {
-		CityName                 string
-		Latitude                 float64
-		Longitude                float64
-		CapturedAt               time.Time
-		Temperature              float64
-		RelativeHumidityPercent  int32
-		WindSpeed                float64
-		WeatherCode              int64
-		CloudCoverPercent        int32
-		PrecipitationMillimeters float64
-		VisibilityMillimeters    float64
-	}
-
 	for rows.Next() {
-		var ic intermediate
-		if err := rows.Scan(
-			&ic.CityName,
-			&ic.Latitude,
-			&ic.Longitude,
-			&ic.CapturedAt,
-			&ic.Temperature,
-			&ic.RelativeHumidityPercent,
-			&ic.WindSpeed,
-			&ic.WeatherCode,
-			&ic.CloudCoverPercent,
-			&ic.PrecipitationMillimeters,
-			&ic.VisibilityMillimeters,
-		); err != nil {
-			logger.Error(ctx, fmt.Sprintf("[%T.GetConditions] Scan error", r), slog.Any("error", err))
+		var ic conditionRow
+		if err := rows.Scan(ic.dest()...); err != nil {
 			return nil, err
 		}
 
-		condition := weather_service.CityWeatherCondition{
-			City: weather_service.City{
-				Name: ic.CityName,
-				Coordinates: weather_service.Coordinates{
-					Lat:  ic.Latitude,
-					Long: ic.Longitude,
-				},
-			},
-			CapturedAt:              ic.CapturedAt,
-			Temperature:             ic.Temperature,
-			RelativeHumidityPercent: uint8(ic.RelativeHumidityPercent),
-			WindSpeed:               ic.WindSpeed,
-			WeatherCode:             enums.WeatherCode(ic.WeatherCode),
-			CloudCoverPercent:       uint8(ic.CloudCoverPercent),
-			Precipitation:           enums.Length(ic.PrecipitationMillimeters),
-			Visibility:              enums.Length(ic.VisibilityMillimeters),
-		}
-
-		conditions = append(conditions, condition)
+		conditions = append(conditions, ic.condition())
 	}
 
 	if err := rows.Err(); err != nil {
-		logger.Error(ctx, fmt.Sprintf("[%T.GetConditions] Rows error", r), slog.Any("error", err))
 		return nil, err
 	}
 
 	return conditions, nil
 }
+
+// conditionRow mirrors conditionColumns and is scanned before being mapped
+// into the domain condition. Measurements are nullable, a NULL column stays a
+// nil measurement.
+type conditionRow struct {
+	CityID                   int64
+	CityName                 string
+	Latitude                 float64
+	Longitude                float64
+	CapturedAt               time.Time
+	Temperature              sql.Null[float64]
+	RelativeHumidityPercent  sql.Null[int32]
+	WindSpeed                sql.Null[float64]
+	WeatherCode              sql.Null[int64]
+	CloudCoverPercent        sql.Null[int32]
+	PrecipitationMillimeters sql.Null[float64]
+	VisibilityMillimeters    sql.Null[float64]
+	Provider                 string
+}
+
+func (ic *conditionRow) dest() []any {
+	return []any{
+		&ic.CityID,
+		&ic.CityName,
+		&ic.Latitude,
+		&ic.Longitude,
+		&ic.CapturedAt,
+		&ic.Temperature,
+		&ic.RelativeHumidityPercent,
+		&ic.WindSpeed,
+		&ic.WeatherCode,
+		&ic.CloudCoverPercent,
+		&ic.PrecipitationMillimeters,
+		&ic.VisibilityMillimeters,
+		&ic.Provider,
+	}
+}
+
+func (ic *conditionRow) condition() weather_service.CityWeatherCondition {
+	return weather_service.CityWeatherCondition{
+		City: weather_service.City{
+			ID:   ic.CityID,
+			Name: ic.CityName,
+			Coordinates: weather_service.Coordinates{
+				Lat:  ic.Latitude,
+				Long: ic.Longitude,
+			},
+		},
+		CapturedAt:              ic.CapturedAt,
+		Temperature:             nullable(ic.Temperature, enums.Celsius),
+		RelativeHumidityPercent: nullable(ic.RelativeHumidityPercent, func(v int32) uint8 { return uint8(v) }),
+		WindSpeed:               nullable(ic.WindSpeed, enums.KilometersPerHour),
+		WeatherCode:             nullable(ic.WeatherCode, func(v int64) enums.WeatherCode { return enums.WeatherCode(v) }),
+		CloudCoverPercent:       nullable(ic.CloudCoverPercent, func(v int32) uint8 { return uint8(v) }),
+		Precipitation:           nullable(ic.PrecipitationMillimeters, enums.Millimeters),
+		Visibility:              nullable(ic.VisibilityMillimeters, enums.Millimeters),
+		Provider:                enums.Provider(ic.Provider),
+	}
+}
+
+func nullable[V, T any](value sql.Null[V], convert func(V) T) *T {
+	if !value.Valid {
+		return nil
+	}
+
+	return ptr.To(convert(value.V))
+}
+
+// millimeters converts a length into the unit of the *_millimeters columns.
+func millimeters(length *enums.Length) *float64 {
+	if length == nil {
+		return nil
+	}
+
+	return ptr.To(length.Millimeters())
+}
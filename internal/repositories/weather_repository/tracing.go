@@ -0,0 +1,30 @@
+package weather_repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts a client span for a call touching table. The returned
+// context has to be used for the queries so they end up under the span.
+func startSpan(ctx context.Context, name, operation, table string) (context.Context, trace.Span) {
+	return otel.Tracer("").Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBCollectionName(table),
+		),
+	)
+}
+
+func rowsAffected(span trace.Span, result sql.Result) {
+	if n, err := result.RowsAffected(); err == nil {
+		span.SetAttributes(telemetry.RowsAffectedKey.Int64(n))
+	}
+}
@@ -0,0 +1,79 @@
+package city_refresh_cron
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/schedulers/periodic"
+	"github.com/robfig/cron/v3"
+	"go.opentelemetry.io/otel"
+)
+
+type Config interface {
+	Duration() time.Duration
+}
+
+type Service interface {
+	Refresh(ctx context.Context) error
+}
+
+type Cron struct {
+	config  Config
+	cron    *cron.Cron
+	service Service
+	job     *periodic.Job
+}
+
+func NewCron(config Config, cron *cron.Cron, service Service) *Cron {
+	c := &Cron{
+		config:  config,
+		cron:    cron,
+		service: service,
+	}
+	c.job = periodic.NewJob("city refresh cron", cron, c.Do)
+
+	return c
+}
+
+// Start schedules periodic reloads of the cities table, it picks up the
+// changes made through other instances.
+func (c *Cron) Start(ctx context.Context) {
+	c.job.Schedule(ctx, c.config.Duration())
+	c.cron.Start()
+	logger.Info(ctx, "city refresh cron successfully started", slog.String("duration", c.job.Interval().String()))
+}
+
+// Reschedule moves the job to the duration the config holds now, it is
+// called after the config was reloaded.
+func (c *Cron) Reschedule(ctx context.Context) {
+	c.job.Schedule(ctx, c.config.Duration())
+}
+
+func (c *Cron) Do(ctx context.Context) {
+	start := time.Now()
+	defer func() {
+		logger.Info(ctx, "successfully done city refresh job", slog.String("timeEstimated", time.Since(start).String()))
+	}()
+
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.Do]", c))
+	defer span.End()
+
+	if err := c.service.Refresh(spanCtx); err != nil {
+		logger.Error(ctx, "error in city refresh cron tick", slog.Any("error", err))
+		return
+	}
+}
+
+func (c *Cron) Stop(ctx context.Context) {
+	stopCtx := c.cron.Stop()
+
+	select {
+	case <-stopCtx.Done():
+		logger.Info(ctx, "city refresh cron successfully stopped")
+	case <-ctx.Done():
+		logger.Warn(ctx, "city refresh cron stop interrupted by context cancellation")
+	}
+}
@@ -0,0 +1,75 @@
+package periodic
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+)
+
+// Interval is the run interval of a job read from a config key. Zero turns
+// the job off and is only accepted when allowZero is set.
+type Interval struct {
+	key       config.Key
+	allowZero bool
+
+	value time.Duration
+	mu    sync.RWMutex
+}
+
+func NewInterval(provider config.Provider, key config.Key, allowZero bool) (*Interval, error) {
+	i := &Interval{
+		key:       key,
+		allowZero: allowZero,
+		mu:        sync.RWMutex{},
+	}
+
+	apply, err := i.Prepare(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	apply()
+	return i, nil
+}
+
+// Prepare validates the interval of provider and returns the function that
+// applies it.
+func (i *Interval) Prepare(provider config.Provider) (func(), error) {
+	value := provider.GetConfigClient().GetValue(i.key).Duration()
+	if err := i.validate(value); err != nil {
+		logger.Error(context.Background(), "unable to update duration value", slog.Any("error", err))
+		return nil, err
+	}
+
+	return func() {
+		i.mu.Lock()
+		defer i.mu.Unlock()
+
+		i.value = value
+		logger.Info(context.Background(), "updated duration value", slog.String(string(i.key), value.String()))
+	}, nil
+}
+
+func (i *Interval) validate(value time.Duration) error {
+	if value < 0 {
+		return fmt.Errorf("%s value in config can not be negative", i.key)
+	}
+
+	if value == 0 && !i.allowZero {
+		return fmt.Errorf("%s value in config must be positive", i.key)
+	}
+
+	return nil
+}
+
+func (i *Interval) Duration() time.Duration {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.value
+}
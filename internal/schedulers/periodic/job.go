@@ -0,0 +1,72 @@
+package periodic
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/robfig/cron/v3"
+)
+
+// Job runs a function every interval on a cron shared with other jobs. The
+// interval can change while the cron runs, a zero interval takes the job off
+// the cron.
+type Job struct {
+	name string
+	cron *cron.Cron
+	run  func(ctx context.Context)
+
+	entryID   cron.EntryID
+	interval  time.Duration
+	scheduled bool
+	mu        sync.Mutex
+}
+
+func NewJob(name string, cron *cron.Cron, run func(ctx context.Context)) *Job {
+	return &Job{
+		name: name,
+		cron: cron,
+		run:  run,
+		mu:   sync.Mutex{},
+	}
+}
+
+// Schedule runs the job every interval from now on and hands ctx to every
+// run. The job keeps its entry when the interval did not change.
+func (j *Job) Schedule(ctx context.Context, interval time.Duration) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.scheduled && interval == j.interval {
+		return
+	}
+
+	if j.entryID != 0 {
+		j.cron.Remove(j.entryID)
+		j.entryID = 0
+	}
+
+	j.interval = interval
+	j.scheduled = true
+
+	if interval == 0 {
+		logger.Info(ctx, fmt.Sprintf("%s is disabled", j.name))
+		return
+	}
+
+	j.entryID = j.cron.Schedule(cron.Every(interval), cron.FuncJob(func() {
+		j.run(ctx)
+	}))
+	logger.Info(ctx, fmt.Sprintf("%s scheduled", j.name), slog.String("duration", interval.String()))
+}
+
+// Interval returns the interval the job runs at, zero while it is off.
+func (j *Job) Interval() time.Duration {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.interval
+}
@@ -0,0 +1,98 @@
+package periodic_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/schedulers/periodic"
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob_Schedule(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	// entry returns the only entry of c with its interval.
+	entry := func(t *testing.T, c *cron.Cron) (cron.EntryID, time.Duration) {
+		t.Helper()
+
+		entries := c.Entries()
+		require.Len(t, entries, 1)
+
+		schedule, ok := entries[0].Schedule.(cron.ConstantDelaySchedule)
+		require.True(t, ok)
+
+		return entries[0].ID, schedule.Delay
+	}
+
+	t.Run("new interval replaces the entry", func(t *testing.T) {
+		t.Parallel()
+
+		c := cron.New()
+		job := periodic.NewJob("test cron", c, func(context.Context) {})
+
+		job.Schedule(context.Background(), time.Minute)
+		first, delay := entry(t, c)
+		assert.Equal(t, time.Minute, delay)
+
+		job.Schedule(context.Background(), time.Minute)
+		id, _ := entry(t, c)
+		assert.Equal(t, first, id)
+
+		job.Schedule(context.Background(), 2*time.Minute)
+		id, delay = entry(t, c)
+		assert.NotEqual(t, first, id)
+		assert.Equal(t, 2*time.Minute, delay)
+		assert.Equal(t, 2*time.Minute, job.Interval())
+	})
+
+	t.Run("zero interval removes the entry", func(t *testing.T) {
+		t.Parallel()
+
+		c := cron.New()
+		job := periodic.NewJob("test cron", c, func(context.Context) {})
+
+		job.Schedule(context.Background(), 0)
+		assert.Empty(t, c.Entries())
+
+		job.Schedule(context.Background(), time.Minute)
+		_, delay := entry(t, c)
+		assert.Equal(t, time.Minute, delay)
+
+		job.Schedule(context.Background(), 0)
+		assert.Empty(t, c.Entries())
+		assert.Zero(t, job.Interval())
+
+		job.Schedule(context.Background(), time.Hour)
+		_, delay = entry(t, c)
+		assert.Equal(t, time.Hour, delay)
+	})
+
+	t.Run("scheduled job runs", func(t *testing.T) {
+		t.Parallel()
+
+		c := cron.New()
+		ran := make(chan struct{}, 1)
+		job := periodic.NewJob("test cron", c, func(context.Context) {
+			select {
+			case ran <- struct{}{}:
+			default:
+			}
+		})
+
+		job.Schedule(context.Background(), time.Second)
+		c.Start()
+		defer c.Stop()
+
+		select {
+		case <-ran:
+		case <-time.After(5 * time.Second):
+			t.Fatal("job did not run")
+		}
+	})
+}
@@ -1,17 +1,12 @@
 package weather_collector_cron
 
 import (
-	"context"
-	"log/slog"
-	"sync"
-	"time"
-
 	"github.com/meteogo/config/pkg/config"
-	"github.com/meteogo/logger/pkg/logger"
 	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/schedulers/periodic"
 )
 
-var _ Config = &configImpl{}
+var _ Config = &periodic.Interval{}
 
 type Provider interface {
 	config.Provider
@@ -25,37 +20,7 @@ type Value interface {
 	config.Value
 }
 
-type configImpl struct {
-	duration time.Duration
-
-	mu sync.RWMutex
-}
-
-func NewConfig(provider Provider) (*configImpl, error) {
-	c := &configImpl{
-		mu: sync.RWMutex{},
-	}
-
-	if err := c.updateDuration(provider.GetConfigClient().GetValue(appconfig.WeatherCollectorCronDuration).Duration()); err != nil {
-		logger.Error(context.Background(), "unable to update duration value", slog.Any("error", err))
-		return nil, err
-	}
-
-	return c, nil
-}
-
-func (c *configImpl) updateDuration(duration time.Duration) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	c.duration = duration
-	logger.Info(context.Background(), "updated duration value", slog.String(string(appconfig.WeatherCollectorCronDuration), duration.String()))
-	return nil
-}
-
-func (c *configImpl) Duration() time.Duration {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	return c.duration
+// NewConfig reads the duration of the cron, it must be positive.
+func NewConfig(provider Provider) (*periodic.Interval, error) {
+	return periodic.NewInterval(provider, appconfig.WeatherCollectorCronDuration, false)
 }
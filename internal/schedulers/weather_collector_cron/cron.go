@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/schedulers/periodic"
 	"github.com/robfig/cron/v3"
 	"go.opentelemetry.io/otel"
 )
@@ -23,23 +24,30 @@ type Cron struct {
 	config  Config
 	cron    *cron.Cron
 	service Service
+	job     *periodic.Job
 }
 
 func NewCron(config Config, cron *cron.Cron, service Service) *Cron {
-	return &Cron{
+	c := &Cron{
 		config:  config,
 		cron:    cron,
 		service: service,
 	}
+	c.job = periodic.NewJob("weather collector cron", cron, c.Do)
+
+	return c
 }
 
 func (c *Cron) Start(ctx context.Context) {
-	c.cron.Schedule(cron.Every(c.config.Duration()), cron.FuncJob(func() {
-		c.Do(ctx)
-	}))
-
+	c.job.Schedule(ctx, c.config.Duration())
 	c.cron.Start()
-	logger.Info(ctx, "weather collector cron successfully started", slog.String("duration", c.config.Duration().String()))
+	logger.Info(ctx, "weather collector cron successfully started", slog.String("duration", c.job.Interval().String()))
+}
+
+// Reschedule moves the job to the duration the config holds now, it is
+// called after the config was reloaded.
+func (c *Cron) Reschedule(ctx context.Context) {
+	c.job.Schedule(ctx, c.config.Duration())
 }
 
 func (c *Cron) Do(ctx context.Context) {
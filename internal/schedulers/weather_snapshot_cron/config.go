@@ -0,0 +1,26 @@
+package weather_snapshot_cron
+
+import (
+	"github.com/meteogo/config/pkg/config"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/schedulers/periodic"
+)
+
+var _ Config = &periodic.Interval{}
+
+type Provider interface {
+	config.Provider
+}
+
+type ConfigClient interface {
+	config.ConfigClient
+}
+
+type Value interface {
+	config.Value
+}
+
+// NewConfig reads the duration of the cron, it must be zero or positive, zero turns the snapshots off.
+func NewConfig(provider Provider) (*periodic.Interval, error) {
+	return periodic.NewInterval(provider, appconfig.WeatherSnapshotCronDuration, true)
+}
@@ -0,0 +1,66 @@
+package alert_service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+)
+
+var _ Config = &configImpl{}
+
+type Provider interface {
+	config.Provider
+}
+
+type configImpl struct {
+	rules Rules
+
+	mu sync.RWMutex
+}
+
+func NewConfig(provider Provider) (*configImpl, error) {
+	c := &configImpl{
+		rules: make(Rules, 0),
+
+		mu: sync.RWMutex{},
+	}
+
+	apply, err := c.Prepare(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	apply()
+	return c, nil
+}
+
+// Prepare parses the rules of provider and returns the function that applies
+// them.
+func (c *configImpl) Prepare(provider Provider) (func(), error) {
+	rules, err := ParseRules(provider.GetConfigClient().GetValue(appconfig.WeatherAlertRules).String())
+	if err != nil {
+		logger.Error(context.Background(), "unable to update weather alert rules value", slog.Any("error", err))
+		return nil, err
+	}
+
+	return func() { c.updateRules(rules) }, nil
+}
+
+func (c *configImpl) updateRules(rules Rules) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rules = rules
+	logger.Info(context.Background(), "updated weather alert rules value", slog.Int("rulesCount", len(rules)))
+}
+
+func (c *configImpl) Rules() Rules {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.rules
+}
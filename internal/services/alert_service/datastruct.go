@@ -0,0 +1,176 @@
+package alert_service
+
+import (
+	"slices"
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+type (
+	Severity string
+	State    string
+	Operator string
+)
+
+const (
+	SeverityInfo     = Severity("info")
+	SeverityWarning  = Severity("warning")
+	SeverityCritical = Severity("critical")
+
+	StateRaised  = State("raised")
+	StateCleared = State("cleared")
+
+	OperatorGreater        = Operator(">")
+	OperatorGreaterOrEqual = Operator(">=")
+	OperatorLess           = Operator("<")
+	OperatorLessOrEqual    = Operator("<=")
+	OperatorEqual          = Operator("==")
+	OperatorNotEqual       = Operator("!=")
+)
+
+type (
+	// Threshold compares one measurement of a reading with Value. Value and
+	// Hysteresis are kept in the unit the measurement is normalised to: °C,
+	// km/h, mm, m, percent or WMO code.
+	//
+	// While the alert is raised the threshold is moved by Hysteresis towards
+	// clearing, e.g. a "> 50" wind threshold with a hysteresis of 5 keeps
+	// holding until the wind drops to 45.
+	Threshold struct {
+		Param      enums.MonitoringParam
+		Operator   Operator
+		Value      float64
+		Hysteresis float64
+	}
+
+	// Rule matches a reading when all of its thresholds hold. It is raised
+	// after RaiseAfter consecutive matching readings of a city and cleared
+	// after ClearAfter consecutive readings that do not match. An empty Cities
+	// applies the rule to every reported city.
+	Rule struct {
+		Name        string
+		Description string
+		Severity    Severity
+		Cities      []string
+		Thresholds  []Threshold
+		RaiseAfter  int
+		ClearAfter  int
+	}
+
+	Rules []Rule
+
+	Alert struct {
+		Rule      Rule
+		State     State
+		Condition weather_service.CityWeatherCondition
+	}
+
+	Alerts []Alert
+
+	// RuleState is the stored state of a rule for one city.
+	RuleState struct {
+		Rule       string
+		CityID     int64
+		Raised     bool
+		Matches    int
+		Misses     int
+		CapturedAt time.Time
+	}
+
+	RuleStates []RuleState
+
+	stateKey struct {
+		rule   string
+		cityID int64
+	}
+
+	// ruleState tracks a rule for one city. capturedAt is the capture time of
+	// the last evaluated reading, the collector asks more often than providers
+	// refresh their data and a repeated reading must not count twice.
+	ruleState struct {
+		raised     bool
+		matches    int
+		misses     int
+		capturedAt time.Time
+	}
+)
+
+func (r Rule) appliesTo(city string) bool {
+	return len(r.Cities) == 0 || slices.Contains(r.Cities, city)
+}
+
+// matches reports whether all thresholds hold for the reading. ok is false
+// when a measurement the rule needs is missing from it.
+func (r Rule) matches(c weather_service.CityWeatherCondition, raised bool) (match bool, ok bool) {
+	match = true
+	for _, t := range r.Thresholds {
+		value, ok := measurement(c, t.Param)
+		if !ok {
+			return false, false
+		}
+
+		match = match && t.holds(value, raised)
+	}
+
+	return match, true
+}
+
+func (t Threshold) holds(value float64, raised bool) bool {
+	threshold := t.Value
+	if raised {
+		switch t.Operator {
+		case OperatorGreater, OperatorGreaterOrEqual:
+			threshold -= t.Hysteresis
+		case OperatorLess, OperatorLessOrEqual:
+			threshold += t.Hysteresis
+		}
+	}
+
+	switch t.Operator {
+	case OperatorGreater:
+		return value > threshold
+	case OperatorGreaterOrEqual:
+		return value >= threshold
+	case OperatorLess:
+		return value < threshold
+	case OperatorLessOrEqual:
+		return value <= threshold
+	case OperatorEqual:
+		return value == threshold
+	case OperatorNotEqual:
+		return value != threshold
+	default:
+		return false
+	}
+}
+
+func measurement(c weather_service.CityWeatherCondition, param enums.MonitoringParam) (float64, bool) {
+	switch param {
+	case enums.MonitoringParamTemperature:
+		return value(c.Temperature, enums.Temperature.Celsius)
+	case enums.MonitoringParamRelativeHumidity:
+		return value(c.RelativeHumidityPercent, func(v uint8) float64 { return float64(v) })
+	case enums.MonitoringParamWindSpeed:
+		return value(c.WindSpeed, enums.Speed.KilometersPerHour)
+	case enums.MonitoringParamWeatherCode:
+		return value(c.WeatherCode, func(v enums.WeatherCode) float64 { return float64(v) })
+	case enums.MonitoringParamCloudCover:
+		return value(c.CloudCoverPercent, func(v uint8) float64 { return float64(v) })
+	case enums.MonitoringParamPrecipitation:
+		return value(c.Precipitation, enums.Length.Millimeters)
+	case enums.MonitoringParamVisibility:
+		return value(c.Visibility, enums.Length.Meters)
+	default:
+		return 0, false
+	}
+}
+
+func value[V any](v *V, convert func(V) float64) (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+
+	return convert(*v), true
+}
@@ -0,0 +1,137 @@
+package alert_service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"gopkg.in/yaml.v3"
+)
+
+// ParseRules reads a YAML list of rules. Every rule has a name, a severity
+// and a list of thresholds under "when", each one made of param, op, value
+// and an optional hysteresis, e.g. {param: windSpeed, op: ">", value: 50,
+// hysteresis: 5}. See weather_alert_rules in .cfg/values.yaml.
+//
+// A threshold may name the unit of its value and hysteresis, e.g. "kn" or
+// "°F", they are converted into the unit the measurement is normalised to.
+// raise_after and clear_after default to 1. An empty value disables alerting.
+func ParseRules(value string) (Rules, error) {
+	type threshold struct {
+		Param      string  `yaml:"param"`
+		Op         string  `yaml:"op"`
+		Value      float64 `yaml:"value"`
+		Hysteresis float64 `yaml:"hysteresis"`
+		Unit       string  `yaml:"unit"`
+	}
+
+	type rule struct {
+		Name        string      `yaml:"name"`
+		Description string      `yaml:"description"`
+		Severity    string      `yaml:"severity"`
+		Cities      []string    `yaml:"cities"`
+		RaiseAfter  int         `yaml:"raise_after"`
+		ClearAfter  int         `yaml:"clear_after"`
+		When        []threshold `yaml:"when"`
+	}
+
+	if strings.TrimSpace(value) == "" {
+		return Rules{}, nil
+	}
+
+	var parsed []rule
+	if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+		return nil, fmt.Errorf("alert rules must be a YAML list: %w", err)
+	}
+
+	rules := make(Rules, 0, len(parsed))
+	names := make(map[string]struct{}, len(parsed))
+	for _, r := range parsed {
+		if r.Name == "" {
+			return nil, errors.New("alert rule name can not be empty")
+		}
+
+		if _, ok := names[r.Name]; ok {
+			return nil, fmt.Errorf("alert rule %q is defined twice", r.Name)
+		}
+		names[r.Name] = struct{}{}
+
+		out := Rule{
+			Name:        r.Name,
+			Description: r.Description,
+			Severity:    Severity(r.Severity),
+			Cities:      r.Cities,
+			Thresholds:  make([]Threshold, 0, len(r.When)),
+			RaiseAfter:  max(r.RaiseAfter, 1),
+			ClearAfter:  max(r.ClearAfter, 1),
+		}
+
+		switch out.Severity {
+		case SeverityInfo, SeverityWarning, SeverityCritical:
+		default:
+			return nil, fmt.Errorf("alert rule %q: unknown severity %q", r.Name, r.Severity)
+		}
+
+		if len(r.When) == 0 {
+			return nil, fmt.Errorf("alert rule %q has no thresholds", r.Name)
+		}
+
+		for _, t := range r.When {
+			threshold, err := parseThreshold(enums.MonitoringParam(t.Param), Operator(t.Op), t.Value, t.Hysteresis, enums.Unit(t.Unit))
+			if err != nil {
+				return nil, fmt.Errorf("alert rule %q: %w", r.Name, err)
+			}
+
+			out.Thresholds = append(out.Thresholds, threshold)
+		}
+
+		rules = append(rules, out)
+	}
+
+	return rules, nil
+}
+
+func parseThreshold(param enums.MonitoringParam, op Operator, value, hysteresis float64, unit enums.Unit) (Threshold, error) {
+	variable, ok := enums.LookupVariable(param)
+	if !ok {
+		return Threshold{}, fmt.Errorf("unknown param %q", param)
+	}
+
+	switch op {
+	case OperatorGreater, OperatorGreaterOrEqual, OperatorLess, OperatorLessOrEqual:
+	case OperatorEqual, OperatorNotEqual:
+		if hysteresis != 0 {
+			return Threshold{}, fmt.Errorf("hysteresis of %s can not be used with %q", param, op)
+		}
+	default:
+		return Threshold{}, fmt.Errorf("unknown operator %q", op)
+	}
+
+	if hysteresis < 0 {
+		return Threshold{}, fmt.Errorf("hysteresis of %s can not be negative", param)
+	}
+
+	canonicalValue, err := unit.ToCanonical(value, variable.Unit)
+	if err != nil {
+		return Threshold{}, err
+	}
+
+	// Hysteresis is a difference, the offset of units like °F cancels out.
+	canonicalHysteresis, err := unit.ToCanonical(hysteresis, variable.Unit)
+	if err != nil {
+		return Threshold{}, err
+	}
+
+	zero, err := unit.ToCanonical(0, variable.Unit)
+	if err != nil {
+		return Threshold{}, err
+	}
+
+	return Threshold{
+		Param:      param,
+		Operator:   op,
+		Value:      canonicalValue,
+		Hysteresis: canonicalHysteresis - zero,
+	}, nil
+}
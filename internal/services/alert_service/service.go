@@ -0,0 +1,239 @@
+package alert_service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"sync"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"go.opentelemetry.io/otel"
+)
+
+//go:generate mockgen -source service.go -destination service_mocks_test.go -package alert_service_test -typed
+
+type Config interface {
+	Rules() Rules
+}
+
+type Publisher interface {
+	PublishAlerts(ctx context.Context, alerts Alerts) error
+}
+
+type Storage interface {
+	GetRuleStates(ctx context.Context) (RuleStates, error)
+	SaveRuleStates(ctx context.Context, states RuleStates) error
+}
+
+// Service evaluates the configured rules against every collected reading
+// and publishes an alert when a rule is raised or cleared for a city. The
+// state of the rules is kept in storage and loaded on the first evaluation,
+// so a restart neither raises an alert again nor forgets a raised one.
+type Service struct {
+	config    Config
+	publisher Publisher
+	storage   Storage
+
+	states map[stateKey]ruleState
+	loaded bool
+	mu     sync.Mutex
+}
+
+func NewService(config Config, publisher Publisher, storage Storage) *Service {
+	return &Service{
+		config:    config,
+		publisher: publisher,
+		storage:   storage,
+		states:    make(map[stateKey]ruleState),
+	}
+}
+
+// Evaluate advances the rules with the readings. The new state is taken
+// before publishing, so a concurrent evaluation does not report the same
+// change, and is put back when publishing fails, so the change is retried
+// with the next readings instead of being lost.
+func (s *Service) Evaluate(ctx context.Context, conditions weather_service.CityWeatherConditions) error {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.Evaluate]", s))
+	defer span.End()
+
+	rules := s.config.Rules()
+	if len(rules) == 0 {
+		return nil
+	}
+
+	alerts, previous, updated, err := s.advance(spanCtx, rules, conditions)
+	if err != nil {
+		logger.Error(ctx, "error loading alert rule states", slog.Any("error", err))
+		return err
+	}
+
+	if len(alerts) > 0 {
+		if err := s.publisher.PublishAlerts(spanCtx, alerts); err != nil {
+			logger.Error(ctx, "error publishing weather alerts", slog.Any("error", err))
+			s.restore(previous, updated)
+			return err
+		}
+
+		logger.Info(ctx, "weather alerts published successfully", slog.Int("alertsCount", len(alerts)))
+	}
+
+	if len(updated) == 0 {
+		return nil
+	}
+
+	if err := s.storage.SaveRuleStates(spanCtx, ruleStates(updated)); err != nil {
+		logger.Error(ctx, "error saving alert rule states", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// advance runs the readings through the rules and stores the states they
+// lead to. It returns the alerts to publish together with the states before
+// and after, for restore.
+func (s *Service) advance(ctx context.Context, rules Rules, conditions weather_service.CityWeatherConditions) (Alerts, map[stateKey]ruleState, map[stateKey]ruleState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(ctx); err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		alerts   = make(Alerts, 0)
+		previous = make(map[stateKey]ruleState)
+		updated  = make(map[stateKey]ruleState)
+	)
+
+	for _, condition := range conditions {
+		for _, rule := range rules {
+			if !rule.appliesTo(condition.City.Name) {
+				continue
+			}
+
+			key := stateKey{rule: rule.Name, cityID: condition.City.ID}
+			state, ok := updated[key]
+			if !ok {
+				state = s.states[key]
+			}
+
+			if !condition.CapturedAt.After(state.capturedAt) {
+				continue
+			}
+
+			if _, ok := previous[key]; !ok {
+				previous[key] = s.states[key]
+			}
+
+			next, changed := state.evaluate(rule, condition)
+			updated[key] = next
+
+			if changed != "" {
+				alerts = append(alerts, Alert{
+					Rule:      rule,
+					State:     changed,
+					Condition: condition,
+				})
+			}
+		}
+	}
+
+	maps.Copy(s.states, updated)
+	return alerts, previous, updated, nil
+}
+
+// restore puts back the states an evaluation replaced, unless a later
+// evaluation has moved them on already.
+func (s *Service) restore(previous, updated map[stateKey]ruleState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, state := range updated {
+		if s.states[key] == state {
+			s.states[key] = previous[key]
+		}
+	}
+}
+
+// load reads the stored states once. A failed load is retried by the next
+// evaluation.
+func (s *Service) load(ctx context.Context) error {
+	if s.loaded {
+		return nil
+	}
+
+	states, err := s.storage.GetRuleStates(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, state := range states {
+		s.states[stateKey{rule: state.Rule, cityID: state.CityID}] = ruleState{
+			raised:     state.Raised,
+			matches:    state.Matches,
+			misses:     state.Misses,
+			capturedAt: state.CapturedAt,
+		}
+	}
+
+	s.loaded = true
+	return nil
+}
+
+func ruleStates(states map[stateKey]ruleState) RuleStates {
+	out := make(RuleStates, 0, len(states))
+	for key, state := range states {
+		out = append(out, RuleState{
+			Rule:       key.rule,
+			CityID:     key.cityID,
+			Raised:     state.raised,
+			Matches:    state.matches,
+			Misses:     state.misses,
+			CapturedAt: state.capturedAt,
+		})
+	}
+
+	return out
+}
+
+// evaluate returns the state after the reading and the state the alert
+// changed to, if it did.
+func (s ruleState) evaluate(rule Rule, condition weather_service.CityWeatherCondition) (ruleState, State) {
+	s.capturedAt = condition.CapturedAt
+
+	match, ok := rule.matches(condition, s.raised)
+	if !ok {
+		return s, ""
+	}
+
+	if !s.raised {
+		if !match {
+			s.matches = 0
+			return s, ""
+		}
+
+		s.matches++
+		if s.matches < rule.RaiseAfter {
+			return s, ""
+		}
+
+		s.raised, s.matches, s.misses = true, 0, 0
+		return s, StateRaised
+	}
+
+	if match {
+		s.misses = 0
+		return s, ""
+	}
+
+	s.misses++
+	if s.misses < rule.ClearAfter {
+		return s, ""
+	}
+
+	s.raised, s.matches, s.misses = false, 0, 0
+	return s, StateCleared
+}
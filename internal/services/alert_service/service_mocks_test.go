@@ -0,0 +1,243 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source service.go -destination service_mocks_test.go -package alert_service_test -typed
+//
+
+// Package alert_service_test is a generated GoMock package.
+package alert_service_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	alert_service "github.com/meteogo/weather-collector-service/internal/services/alert_service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfig is a mock of Config interface.
+type MockConfig struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigMockRecorder
+	isgomock struct{}
+}
+
+// MockConfigMockRecorder is the mock recorder for MockConfig.
+type MockConfigMockRecorder struct {
+	mock *MockConfig
+}
+
+// NewMockConfig creates a new mock instance.
+func NewMockConfig(ctrl *gomock.Controller) *MockConfig {
+	mock := &MockConfig{ctrl: ctrl}
+	mock.recorder = &MockConfigMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfig) EXPECT() *MockConfigMockRecorder {
+	return m.recorder
+}
+
+// Rules mocks base method.
+func (m *MockConfig) Rules() alert_service.Rules {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rules")
+	ret0, _ := ret[0].(alert_service.Rules)
+	return ret0
+}
+
+// Rules indicates an expected call of Rules.
+func (mr *MockConfigMockRecorder) Rules() *MockConfigRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rules", reflect.TypeOf((*MockConfig)(nil).Rules))
+	return &MockConfigRulesCall{Call: call}
+}
+
+// MockConfigRulesCall wrap *gomock.Call
+type MockConfigRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigRulesCall) Return(arg0 alert_service.Rules) *MockConfigRulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigRulesCall) Do(f func() alert_service.Rules) *MockConfigRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigRulesCall) DoAndReturn(f func() alert_service.Rules) *MockConfigRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockPublisher is a mock of Publisher interface.
+type MockPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockPublisherMockRecorder is the mock recorder for MockPublisher.
+type MockPublisherMockRecorder struct {
+	mock *MockPublisher
+}
+
+// NewMockPublisher creates a new mock instance.
+func NewMockPublisher(ctrl *gomock.Controller) *MockPublisher {
+	mock := &MockPublisher{ctrl: ctrl}
+	mock.recorder = &MockPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublisher) EXPECT() *MockPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishAlerts mocks base method.
+func (m *MockPublisher) PublishAlerts(ctx context.Context, alerts alert_service.Alerts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishAlerts", ctx, alerts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishAlerts indicates an expected call of PublishAlerts.
+func (mr *MockPublisherMockRecorder) PublishAlerts(ctx, alerts any) *MockPublisherPublishAlertsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishAlerts", reflect.TypeOf((*MockPublisher)(nil).PublishAlerts), ctx, alerts)
+	return &MockPublisherPublishAlertsCall{Call: call}
+}
+
+// MockPublisherPublishAlertsCall wrap *gomock.Call
+type MockPublisherPublishAlertsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPublisherPublishAlertsCall) Return(arg0 error) *MockPublisherPublishAlertsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPublisherPublishAlertsCall) Do(f func(context.Context, alert_service.Alerts) error) *MockPublisherPublishAlertsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPublisherPublishAlertsCall) DoAndReturn(f func(context.Context, alert_service.Alerts) error) *MockPublisherPublishAlertsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// GetRuleStates mocks base method.
+func (m *MockStorage) GetRuleStates(ctx context.Context) (alert_service.RuleStates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleStates", ctx)
+	ret0, _ := ret[0].(alert_service.RuleStates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleStates indicates an expected call of GetRuleStates.
+func (mr *MockStorageMockRecorder) GetRuleStates(ctx any) *MockStorageGetRuleStatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleStates", reflect.TypeOf((*MockStorage)(nil).GetRuleStates), ctx)
+	return &MockStorageGetRuleStatesCall{Call: call}
+}
+
+// MockStorageGetRuleStatesCall wrap *gomock.Call
+type MockStorageGetRuleStatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetRuleStatesCall) Return(arg0 alert_service.RuleStates, arg1 error) *MockStorageGetRuleStatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetRuleStatesCall) Do(f func(context.Context) (alert_service.RuleStates, error)) *MockStorageGetRuleStatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetRuleStatesCall) DoAndReturn(f func(context.Context) (alert_service.RuleStates, error)) *MockStorageGetRuleStatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveRuleStates mocks base method.
+func (m *MockStorage) SaveRuleStates(ctx context.Context, states alert_service.RuleStates) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRuleStates", ctx, states)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRuleStates indicates an expected call of SaveRuleStates.
+func (mr *MockStorageMockRecorder) SaveRuleStates(ctx, states any) *MockStorageSaveRuleStatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRuleStates", reflect.TypeOf((*MockStorage)(nil).SaveRuleStates), ctx, states)
+	return &MockStorageSaveRuleStatesCall{Call: call}
+}
+
+// MockStorageSaveRuleStatesCall wrap *gomock.Call
+type MockStorageSaveRuleStatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSaveRuleStatesCall) Return(arg0 error) *MockStorageSaveRuleStatesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSaveRuleStatesCall) Do(f func(context.Context, alert_service.RuleStates) error) *MockStorageSaveRuleStatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSaveRuleStatesCall) DoAndReturn(f func(context.Context, alert_service.RuleStates) error) *MockStorageSaveRuleStatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
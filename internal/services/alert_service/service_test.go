@@ -0,0 +1,433 @@
+package alert_service_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/alert_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	strongWind = alert_service.Rule{
+		Name:     "strong_wind",
+		Severity: alert_service.SeverityWarning,
+		Thresholds: []alert_service.Threshold{
+			{Param: enums.MonitoringParamWindSpeed, Operator: alert_service.OperatorGreater, Value: 50, Hysteresis: 5},
+		},
+		RaiseAfter: 2,
+		ClearAfter: 1,
+	}
+
+	freezingPrecipitation = alert_service.Rule{
+		Name:     "freezing_precipitation",
+		Severity: alert_service.SeverityWarning,
+		Cities:   []string{"Berlin"},
+		Thresholds: []alert_service.Threshold{
+			{Param: enums.MonitoringParamTemperature, Operator: alert_service.OperatorLess, Value: 0},
+			{Param: enums.MonitoringParamPrecipitation, Operator: alert_service.OperatorGreater, Value: 0},
+		},
+		RaiseAfter: 1,
+		ClearAfter: 1,
+	}
+
+	start = time.Date(2025, 7, 10, 12, 0, 0, 0, time.UTC)
+
+	cityIDs = map[string]int64{"Berlin": 1, "Paris": 2}
+)
+
+func reading(city string, minutes int, wind float64) weather_service.CityWeatherCondition {
+	return weather_service.CityWeatherCondition{
+		City:        weather_service.City{ID: cityIDs[city], Name: city},
+		CapturedAt:  start.Add(time.Duration(minutes) * time.Minute),
+		Temperature: ptr.To(enums.Celsius(5)),
+		WindSpeed:   ptr.To(enums.KilometersPerHour(wind)),
+	}
+}
+
+func freezing(city string, minutes int, temperature float64, precipitation enums.Length) weather_service.CityWeatherCondition {
+	return weather_service.CityWeatherCondition{
+		City:          weather_service.City{ID: cityIDs[city], Name: city},
+		CapturedAt:    start.Add(time.Duration(minutes) * time.Minute),
+		Temperature:   ptr.To(enums.Celsius(temperature)),
+		Precipitation: ptr.To(precipitation),
+	}
+}
+
+type wantAlert struct {
+	rule  string
+	city  string
+	state alert_service.State
+}
+
+type step struct {
+	conditions weather_service.CityWeatherConditions
+	publishErr error
+	want       []wantAlert
+	wantErr    bool
+}
+
+func TestAlertService_Evaluate(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	tests := []struct {
+		name  string
+		rules alert_service.Rules
+		steps []step
+	}{
+		{
+			name:  "raises after consecutive readings",
+			rules: alert_service.Rules{strongWind},
+			steps: []step{
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 0, 60)}},
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 15, 40)}},
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 30, 60)}},
+				{
+					conditions: weather_service.CityWeatherConditions{reading("Berlin", 45, 61)},
+					want:       []wantAlert{{rule: "strong_wind", city: "Berlin", state: alert_service.StateRaised}},
+				},
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 60, 70)}},
+			},
+		},
+		{
+			name:  "repeated reading is counted once",
+			rules: alert_service.Rules{strongWind},
+			steps: []step{
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 0, 60)}},
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 0, 60)}},
+				{
+					conditions: weather_service.CityWeatherConditions{reading("Berlin", 15, 60)},
+					want:       []wantAlert{{rule: "strong_wind", city: "Berlin", state: alert_service.StateRaised}},
+				},
+			},
+		},
+		{
+			name:  "clears below hysteresis",
+			rules: alert_service.Rules{strongWind},
+			steps: []step{
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 0, 55)}},
+				{
+					conditions: weather_service.CityWeatherConditions{reading("Berlin", 15, 55)},
+					want:       []wantAlert{{rule: "strong_wind", city: "Berlin", state: alert_service.StateRaised}},
+				},
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 30, 47)}},
+				{
+					conditions: weather_service.CityWeatherConditions{reading("Berlin", 45, 44)},
+					want:       []wantAlert{{rule: "strong_wind", city: "Berlin", state: alert_service.StateCleared}},
+				},
+			},
+		},
+		{
+			name:  "all thresholds must hold in a listed city",
+			rules: alert_service.Rules{freezingPrecipitation},
+			steps: []step{
+				{conditions: weather_service.CityWeatherConditions{freezing("Berlin", 0, -2, 0)}},
+				{conditions: weather_service.CityWeatherConditions{freezing("Paris", 0, -2, enums.Millimeter)}},
+				{
+					conditions: weather_service.CityWeatherConditions{
+						freezing("Berlin", 15, -2, enums.Millimeter),
+						freezing("Paris", 15, -2, enums.Millimeter),
+					},
+					want: []wantAlert{{rule: "freezing_precipitation", city: "Berlin", state: alert_service.StateRaised}},
+				},
+			},
+		},
+		{
+			name:  "missing measurement leaves the state as is",
+			rules: alert_service.Rules{strongWind},
+			steps: []step{
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 0, 60)}},
+				{conditions: weather_service.CityWeatherConditions{freezing("Berlin", 15, 5, 0)}},
+				{
+					conditions: weather_service.CityWeatherConditions{reading("Berlin", 30, 60)},
+					want:       []wantAlert{{rule: "strong_wind", city: "Berlin", state: alert_service.StateRaised}},
+				},
+			},
+		},
+		{
+			name:  "failed publish is retried with the next reading",
+			rules: alert_service.Rules{strongWind},
+			steps: []step{
+				{conditions: weather_service.CityWeatherConditions{reading("Berlin", 0, 60)}},
+				{
+					conditions: weather_service.CityWeatherConditions{reading("Berlin", 15, 60)},
+					publishErr: errors.New("kafka error"),
+					want:       []wantAlert{{rule: "strong_wind", city: "Berlin", state: alert_service.StateRaised}},
+					wantErr:    true,
+				},
+				{
+					conditions: weather_service.CityWeatherConditions{reading("Berlin", 30, 60)},
+					want:       []wantAlert{{rule: "strong_wind", city: "Berlin", state: alert_service.StateRaised}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			config := NewMockConfig(ctrl)
+			config.EXPECT().
+				Rules().
+				Return(tt.rules).
+				AnyTimes()
+
+			storage := NewMockStorage(ctrl)
+			storage.EXPECT().
+				GetRuleStates(gomock.Any()).
+				Return(nil, nil).
+				Times(1)
+			storage.EXPECT().
+				SaveRuleStates(gomock.Any(), gomock.Any()).
+				Return(nil).
+				AnyTimes()
+
+			publisher := NewMockPublisher(ctrl)
+			service := alert_service.NewService(config, publisher, storage)
+
+			for i, s := range tt.steps {
+				if len(s.want) > 0 {
+					publisher.EXPECT().
+						PublishAlerts(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, alerts alert_service.Alerts) error {
+							got := make([]wantAlert, 0, len(alerts))
+							for _, alert := range alerts {
+								got = append(got, wantAlert{rule: alert.Rule.Name, city: alert.Condition.City.Name, state: alert.State})
+							}
+
+							assert.ElementsMatch(t, s.want, got, "step %d", i)
+							return s.publishErr
+						}).
+						Times(1)
+				}
+
+				err := service.Evaluate(context.Background(), s.conditions)
+				if s.wantErr {
+					assert.Error(t, err, "step %d", i)
+				} else {
+					assert.NoError(t, err, "step %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestAlertService_EvaluateRestoresState(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	ctrl := gomock.NewController(t)
+
+	config := NewMockConfig(ctrl)
+	config.EXPECT().
+		Rules().
+		Return(alert_service.Rules{strongWind}).
+		AnyTimes()
+
+	publisher := NewMockPublisher(ctrl)
+	publisher.EXPECT().
+		PublishAlerts(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, alerts alert_service.Alerts) error {
+			assert.Len(t, alerts, 1)
+			assert.Equal(t, alert_service.StateRaised, alerts[0].State)
+			return nil
+		}).
+		Times(1)
+
+	var saved alert_service.RuleStates
+	storage := NewMockStorage(ctrl)
+	storage.EXPECT().
+		GetRuleStates(gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+	storage.EXPECT().
+		SaveRuleStates(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, states alert_service.RuleStates) error {
+			saved = states
+			return nil
+		}).
+		Times(2)
+
+	service := alert_service.NewService(config, publisher, storage)
+	require.NoError(t, service.Evaluate(context.Background(), weather_service.CityWeatherConditions{reading("Berlin", 0, 60)}))
+	require.NoError(t, service.Evaluate(context.Background(), weather_service.CityWeatherConditions{reading("Berlin", 15, 60)}))
+	require.Equal(t, alert_service.RuleStates{
+		{Rule: "strong_wind", CityID: 1, Raised: true, CapturedAt: start.Add(15 * time.Minute)},
+	}, saved)
+
+	t.Run("restarted service clears the raised rule", func(t *testing.T) {
+		restartedPublisher := NewMockPublisher(ctrl)
+		restartedPublisher.EXPECT().
+			PublishAlerts(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, alerts alert_service.Alerts) error {
+				assert.Len(t, alerts, 1)
+				assert.Equal(t, alert_service.StateCleared, alerts[0].State)
+				return nil
+			}).
+			Times(1)
+
+		restartedStorage := NewMockStorage(ctrl)
+		restartedStorage.EXPECT().
+			GetRuleStates(gomock.Any()).
+			Return(saved, nil).
+			Times(1)
+		restartedStorage.EXPECT().
+			SaveRuleStates(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		restarted := alert_service.NewService(config, restartedPublisher, restartedStorage)
+		assert.NoError(t, restarted.Evaluate(context.Background(), weather_service.CityWeatherConditions{reading("Berlin", 30, 40)}))
+	})
+
+	t.Run("failed load is retried", func(t *testing.T) {
+		failingStorage := NewMockStorage(ctrl)
+		gomock.InOrder(
+			failingStorage.EXPECT().
+				GetRuleStates(gomock.Any()).
+				Return(nil, errors.New("connection refused")),
+			failingStorage.EXPECT().
+				GetRuleStates(gomock.Any()).
+				Return(saved, nil),
+		)
+		failingStorage.EXPECT().
+			SaveRuleStates(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		service := alert_service.NewService(config, NewMockPublisher(ctrl), failingStorage)
+		assert.Error(t, service.Evaluate(context.Background(), weather_service.CityWeatherConditions{reading("Berlin", 30, 60)}))
+		assert.NoError(t, service.Evaluate(context.Background(), weather_service.CityWeatherConditions{reading("Berlin", 30, 60)}))
+	})
+}
+
+func TestParseRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		value     string
+		want      alert_service.Rules
+		wantError bool
+	}{
+		{
+			name:  "empty value disables alerting",
+			value: " \n",
+			want:  alert_service.Rules{},
+		},
+		{
+			name: "thresholds are converted into normalised units",
+			value: `
+- name: strong_wind
+  severity: warning
+  cities: [Berlin]
+  raise_after: 3
+  when:
+    - {param: windSpeed, op: ">", value: 10, hysteresis: 5, unit: "m/s"}
+- name: frost
+  severity: info
+  when:
+    - {param: temperature, op: "<=", value: 32, hysteresis: 9, unit: "°F"}
+`,
+			want: alert_service.Rules{
+				{
+					Name:     "strong_wind",
+					Severity: alert_service.SeverityWarning,
+					Cities:   []string{"Berlin"},
+					Thresholds: []alert_service.Threshold{
+						{Param: enums.MonitoringParamWindSpeed, Operator: alert_service.OperatorGreater, Value: 36, Hysteresis: 18},
+					},
+					RaiseAfter: 3,
+					ClearAfter: 1,
+				},
+				{
+					Name:     "frost",
+					Severity: alert_service.SeverityInfo,
+					Thresholds: []alert_service.Threshold{
+						{Param: enums.MonitoringParamTemperature, Operator: alert_service.OperatorLessOrEqual, Value: 0, Hysteresis: 5},
+					},
+					RaiseAfter: 1,
+					ClearAfter: 1,
+				},
+			},
+		},
+		{
+			name:      "unknown param",
+			value:     `[{name: a, severity: info, when: [{param: pressure, op: ">", value: 1}]}]`,
+			wantError: true,
+		},
+		{
+			name:      "unknown operator",
+			value:     `[{name: a, severity: info, when: [{param: windSpeed, op: "=>", value: 1}]}]`,
+			wantError: true,
+		},
+		{
+			name:      "unknown severity",
+			value:     `[{name: a, severity: fatal, when: [{param: windSpeed, op: ">", value: 1}]}]`,
+			wantError: true,
+		},
+		{
+			name:      "unit of another quantity",
+			value:     `[{name: a, severity: info, when: [{param: windSpeed, op: ">", value: 1, unit: "mm"}]}]`,
+			wantError: true,
+		},
+		{
+			name:      "hysteresis with equality",
+			value:     `[{name: a, severity: info, when: [{param: weatherCode, op: "==", value: 95, hysteresis: 1}]}]`,
+			wantError: true,
+		},
+		{
+			name:      "rule without thresholds",
+			value:     `[{name: a, severity: info}]`,
+			wantError: true,
+		},
+		{
+			name:      "rule defined twice",
+			value:     `[{name: a, severity: info, when: [{param: windSpeed, op: ">", value: 1}]}, {name: a, severity: info, when: [{param: windSpeed, op: ">", value: 2}]}]`,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := alert_service.ParseRules(tt.value)
+			if tt.wantError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.want), len(got))
+			for i := range tt.want {
+				assert.Equal(t, tt.want[i].Name, got[i].Name)
+				assert.Equal(t, tt.want[i].Severity, got[i].Severity)
+				assert.Equal(t, tt.want[i].Cities, got[i].Cities)
+				assert.Equal(t, tt.want[i].RaiseAfter, got[i].RaiseAfter)
+				assert.Equal(t, tt.want[i].ClearAfter, got[i].ClearAfter)
+
+				assert.Equal(t, len(tt.want[i].Thresholds), len(got[i].Thresholds))
+				for j, want := range tt.want[i].Thresholds {
+					assert.Equal(t, want.Param, got[i].Thresholds[j].Param)
+					assert.Equal(t, want.Operator, got[i].Thresholds[j].Operator)
+					assert.InDelta(t, want.Value, got[i].Thresholds[j].Value, 1e-9)
+					assert.InDelta(t, want.Hysteresis, got[i].Thresholds[j].Hysteresis, 1e-9)
+				}
+			}
+		})
+	}
+}
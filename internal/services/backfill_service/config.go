@@ -0,0 +1,76 @@
+package backfill_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+var _ Config = &configImpl{}
+
+type Provider interface {
+	config.Provider
+}
+
+type configImpl struct {
+	archiveParams weather_service.MonitoringParamsMap
+
+	mu sync.RWMutex
+}
+
+func NewConfig(provider Provider) (*configImpl, error) {
+	c := &configImpl{
+		archiveParams: make(weather_service.MonitoringParamsMap),
+
+		mu: sync.RWMutex{},
+	}
+
+	if err := c.updateArchiveParams(provider.GetConfigClient().GetValue(appconfig.ArchiveMonitoringParams).String()); err != nil {
+		logger.Error(context.Background(), "unable to update archive monitoring params value", slog.Any("error", err))
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *configImpl) updateArchiveParams(JSON string) error {
+	params := make(map[string]string)
+	if err := json.Unmarshal([]byte(JSON), &params); err != nil {
+		return err
+	}
+
+	archiveParams := make(weather_service.MonitoringParamsMap)
+	for k, v := range params {
+		archiveParams[enums.MonitoringParam(k)] = v
+	}
+
+	if len(archiveParams) == 0 {
+		return errors.New("size of archive monitoring params can not be zero")
+	}
+
+	if err := archiveParams.Validate(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.archiveParams = archiveParams
+	logger.Info(context.Background(), "updated archive monitoring params value", slog.Any(string(appconfig.ArchiveMonitoringParams), archiveParams))
+	return nil
+}
+
+func (c *configImpl) ArchiveParams() weather_service.MonitoringParamsMap {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.archiveParams
+}
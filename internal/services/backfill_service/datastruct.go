@@ -0,0 +1,24 @@
+package backfill_service
+
+import (
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+type (
+	Request struct {
+		City      weather_service.City
+		From      time.Time
+		To        time.Time
+		ChunkDays int
+		Publish   bool
+	}
+
+	Progress struct {
+		CityID   int64
+		From     time.Time
+		To       time.Time
+		NextDate time.Time
+	}
+)
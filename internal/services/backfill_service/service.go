@@ -0,0 +1,143 @@
+package backfill_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"go.opentelemetry.io/otel"
+)
+
+//go:generate mockgen -source service.go -destination service_mocks_test.go -package backfill_service_test -typed
+
+type Config interface {
+	ArchiveParams() weather_service.MonitoringParamsMap
+}
+
+type ArchiveClient interface {
+	ArchiveWeather(ctx context.Context, city weather_service.City, params weather_service.MonitoringParamsMap, from, to time.Time) (weather_service.CityWeatherConditions, error)
+}
+
+type Publisher interface {
+	PublishConditions(ctx context.Context, conditions weather_service.CityWeatherConditions) error
+}
+
+type Storage interface {
+	GetBackfillProgress(ctx context.Context, cityID int64, from, to time.Time) (Progress, bool, error)
+	SaveBackfillChunk(ctx context.Context, progress Progress, conditions weather_service.CityWeatherConditions) error
+}
+
+type Service struct {
+	config        Config
+	archiveClient ArchiveClient
+	publisher     Publisher
+	storage       Storage
+}
+
+func NewService(
+	config Config,
+	archiveClient ArchiveClient,
+	publisher Publisher,
+	storage Storage,
+) *Service {
+	return &Service{
+		config:        config,
+		archiveClient: archiveClient,
+		publisher:     publisher,
+		storage:       storage,
+	}
+}
+
+// Backfill loads archived hourly conditions for req.City chunk by chunk. Every
+// stored chunk advances the persisted progress, so a rerun with the same range
+// continues after the last completed chunk.
+func (s *Service) Backfill(ctx context.Context, req Request) error {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.Backfill]", s))
+	defer span.End()
+
+	if err := validateRequest(req); err != nil {
+		return err
+	}
+
+	if req.Publish && s.publisher == nil {
+		return errors.New("publishing requested but no publisher configured")
+	}
+
+	var (
+		from = truncateToDate(req.From)
+		to   = truncateToDate(req.To)
+		next = from
+	)
+
+	progress, found, err := s.storage.GetBackfillProgress(spanCtx, req.City.ID, from, to)
+	if err != nil {
+		logger.Error(ctx, "unable to get backfill progress", slog.String("city", req.City.Name), slog.Any("error", err))
+		return err
+	}
+
+	if found {
+		next = progress.NextDate
+		logger.Info(ctx, "resuming backfill", slog.String("city", req.City.Name), slog.String("nextDate", next.Format(time.DateOnly)))
+	}
+
+	params := s.config.ArchiveParams()
+	for chunkStart := next; !chunkStart.After(to); {
+		chunkEnd := chunkStart.AddDate(0, 0, req.ChunkDays-1)
+		if chunkEnd.After(to) {
+			chunkEnd = to
+		}
+
+		conditions, err := s.archiveClient.ArchiveWeather(spanCtx, req.City, params, chunkStart, chunkEnd)
+		if err != nil {
+			logger.Error(ctx, "unable to get archive weather", slog.String("city", req.City.Name), slog.String("chunkStart", chunkStart.Format(time.DateOnly)), slog.Any("error", err))
+			return err
+		}
+
+		if req.Publish && len(conditions) > 0 {
+			if err := s.publisher.PublishConditions(spanCtx, conditions); err != nil {
+				logger.Error(ctx, "unable to publish backfilled conditions", slog.String("city", req.City.Name), slog.Any("error", err))
+				return err
+			}
+		}
+
+		chunkStart = chunkEnd.AddDate(0, 0, 1)
+		if err := s.storage.SaveBackfillChunk(spanCtx, Progress{
+			CityID:   req.City.ID,
+			From:     from,
+			To:       to,
+			NextDate: chunkStart,
+		}, conditions); err != nil {
+			logger.Error(ctx, "unable to save backfill chunk", slog.String("city", req.City.Name), slog.Any("error", err))
+			return err
+		}
+
+		logger.Info(ctx, "backfill chunk saved", slog.String("city", req.City.Name), slog.String("chunkEnd", chunkEnd.Format(time.DateOnly)), slog.Int("conditionsCount", len(conditions)))
+	}
+
+	logger.Info(ctx, "backfill completed", slog.String("city", req.City.Name))
+	return nil
+}
+
+func validateRequest(req Request) error {
+	if req.City.Name == "" {
+		return errors.New("backfill city name can not be empty")
+	}
+
+	if req.ChunkDays < 1 {
+		return errors.New("backfill chunk days can not be less than 1")
+	}
+
+	if req.From.IsZero() || req.To.IsZero() || req.To.Before(req.From) {
+		return errors.New("backfill range is invalid")
+	}
+
+	return nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
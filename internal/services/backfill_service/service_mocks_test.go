@@ -0,0 +1,309 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source service.go -destination service_mocks_test.go -package backfill_service_test -typed
+//
+
+// Package backfill_service_test is a generated GoMock package.
+package backfill_service_test
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	backfill_service "github.com/meteogo/weather-collector-service/internal/services/backfill_service"
+	weather_service "github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfig is a mock of Config interface.
+type MockConfig struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigMockRecorder
+	isgomock struct{}
+}
+
+// MockConfigMockRecorder is the mock recorder for MockConfig.
+type MockConfigMockRecorder struct {
+	mock *MockConfig
+}
+
+// NewMockConfig creates a new mock instance.
+func NewMockConfig(ctrl *gomock.Controller) *MockConfig {
+	mock := &MockConfig{ctrl: ctrl}
+	mock.recorder = &MockConfigMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfig) EXPECT() *MockConfigMockRecorder {
+	return m.recorder
+}
+
+// ArchiveParams mocks base method.
+func (m *MockConfig) ArchiveParams() weather_service.MonitoringParamsMap {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveParams")
+	ret0, _ := ret[0].(weather_service.MonitoringParamsMap)
+	return ret0
+}
+
+// ArchiveParams indicates an expected call of ArchiveParams.
+func (mr *MockConfigMockRecorder) ArchiveParams() *MockConfigArchiveParamsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveParams", reflect.TypeOf((*MockConfig)(nil).ArchiveParams))
+	return &MockConfigArchiveParamsCall{Call: call}
+}
+
+// MockConfigArchiveParamsCall wrap *gomock.Call
+type MockConfigArchiveParamsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigArchiveParamsCall) Return(arg0 weather_service.MonitoringParamsMap) *MockConfigArchiveParamsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigArchiveParamsCall) Do(f func() weather_service.MonitoringParamsMap) *MockConfigArchiveParamsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigArchiveParamsCall) DoAndReturn(f func() weather_service.MonitoringParamsMap) *MockConfigArchiveParamsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockArchiveClient is a mock of ArchiveClient interface.
+type MockArchiveClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveClientMockRecorder
+	isgomock struct{}
+}
+
+// MockArchiveClientMockRecorder is the mock recorder for MockArchiveClient.
+type MockArchiveClientMockRecorder struct {
+	mock *MockArchiveClient
+}
+
+// NewMockArchiveClient creates a new mock instance.
+func NewMockArchiveClient(ctrl *gomock.Controller) *MockArchiveClient {
+	mock := &MockArchiveClient{ctrl: ctrl}
+	mock.recorder = &MockArchiveClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveClient) EXPECT() *MockArchiveClientMockRecorder {
+	return m.recorder
+}
+
+// ArchiveWeather mocks base method.
+func (m *MockArchiveClient) ArchiveWeather(ctx context.Context, city weather_service.City, params weather_service.MonitoringParamsMap, from, to time.Time) (weather_service.CityWeatherConditions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveWeather", ctx, city, params, from, to)
+	ret0, _ := ret[0].(weather_service.CityWeatherConditions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveWeather indicates an expected call of ArchiveWeather.
+func (mr *MockArchiveClientMockRecorder) ArchiveWeather(ctx, city, params, from, to any) *MockArchiveClientArchiveWeatherCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveWeather", reflect.TypeOf((*MockArchiveClient)(nil).ArchiveWeather), ctx, city, params, from, to)
+	return &MockArchiveClientArchiveWeatherCall{Call: call}
+}
+
+// MockArchiveClientArchiveWeatherCall wrap *gomock.Call
+type MockArchiveClientArchiveWeatherCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockArchiveClientArchiveWeatherCall) Return(arg0 weather_service.CityWeatherConditions, arg1 error) *MockArchiveClientArchiveWeatherCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockArchiveClientArchiveWeatherCall) Do(f func(context.Context, weather_service.City, weather_service.MonitoringParamsMap, time.Time, time.Time) (weather_service.CityWeatherConditions, error)) *MockArchiveClientArchiveWeatherCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockArchiveClientArchiveWeatherCall) DoAndReturn(f func(context.Context, weather_service.City, weather_service.MonitoringParamsMap, time.Time, time.Time) (weather_service.CityWeatherConditions, error)) *MockArchiveClientArchiveWeatherCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockPublisher is a mock of Publisher interface.
+type MockPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockPublisherMockRecorder is the mock recorder for MockPublisher.
+type MockPublisherMockRecorder struct {
+	mock *MockPublisher
+}
+
+// NewMockPublisher creates a new mock instance.
+func NewMockPublisher(ctrl *gomock.Controller) *MockPublisher {
+	mock := &MockPublisher{ctrl: ctrl}
+	mock.recorder = &MockPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublisher) EXPECT() *MockPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishConditions mocks base method.
+func (m *MockPublisher) PublishConditions(ctx context.Context, conditions weather_service.CityWeatherConditions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishConditions", ctx, conditions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishConditions indicates an expected call of PublishConditions.
+func (mr *MockPublisherMockRecorder) PublishConditions(ctx, conditions any) *MockPublisherPublishConditionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishConditions", reflect.TypeOf((*MockPublisher)(nil).PublishConditions), ctx, conditions)
+	return &MockPublisherPublishConditionsCall{Call: call}
+}
+
+// MockPublisherPublishConditionsCall wrap *gomock.Call
+type MockPublisherPublishConditionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPublisherPublishConditionsCall) Return(arg0 error) *MockPublisherPublishConditionsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPublisherPublishConditionsCall) Do(f func(context.Context, weather_service.CityWeatherConditions) error) *MockPublisherPublishConditionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPublisherPublishConditionsCall) DoAndReturn(f func(context.Context, weather_service.CityWeatherConditions) error) *MockPublisherPublishConditionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// GetBackfillProgress mocks base method.
+func (m *MockStorage) GetBackfillProgress(ctx context.Context, cityID int64, from, to time.Time) (backfill_service.Progress, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackfillProgress", ctx, cityID, from, to)
+	ret0, _ := ret[0].(backfill_service.Progress)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackfillProgress indicates an expected call of GetBackfillProgress.
+func (mr *MockStorageMockRecorder) GetBackfillProgress(ctx, cityID, from, to any) *MockStorageGetBackfillProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackfillProgress", reflect.TypeOf((*MockStorage)(nil).GetBackfillProgress), ctx, cityID, from, to)
+	return &MockStorageGetBackfillProgressCall{Call: call}
+}
+
+// MockStorageGetBackfillProgressCall wrap *gomock.Call
+type MockStorageGetBackfillProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetBackfillProgressCall) Return(arg0 backfill_service.Progress, arg1 bool, arg2 error) *MockStorageGetBackfillProgressCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetBackfillProgressCall) Do(f func(context.Context, int64, time.Time, time.Time) (backfill_service.Progress, bool, error)) *MockStorageGetBackfillProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetBackfillProgressCall) DoAndReturn(f func(context.Context, int64, time.Time, time.Time) (backfill_service.Progress, bool, error)) *MockStorageGetBackfillProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBackfillChunk mocks base method.
+func (m *MockStorage) SaveBackfillChunk(ctx context.Context, progress backfill_service.Progress, conditions weather_service.CityWeatherConditions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBackfillChunk", ctx, progress, conditions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBackfillChunk indicates an expected call of SaveBackfillChunk.
+func (mr *MockStorageMockRecorder) SaveBackfillChunk(ctx, progress, conditions any) *MockStorageSaveBackfillChunkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBackfillChunk", reflect.TypeOf((*MockStorage)(nil).SaveBackfillChunk), ctx, progress, conditions)
+	return &MockStorageSaveBackfillChunkCall{Call: call}
+}
+
+// MockStorageSaveBackfillChunkCall wrap *gomock.Call
+type MockStorageSaveBackfillChunkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSaveBackfillChunkCall) Return(arg0 error) *MockStorageSaveBackfillChunkCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSaveBackfillChunkCall) Do(f func(context.Context, backfill_service.Progress, weather_service.CityWeatherConditions) error) *MockStorageSaveBackfillChunkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSaveBackfillChunkCall) DoAndReturn(f func(context.Context, backfill_service.Progress, weather_service.CityWeatherConditions) error) *MockStorageSaveBackfillChunkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
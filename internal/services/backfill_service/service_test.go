@@ -0,0 +1,242 @@
+package backfill_service_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/backfill_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	berlin = weather_service.City{
+		ID:   1,
+		Name: "Berlin",
+		Coordinates: weather_service.Coordinates{
+			Lat:  52.52,
+			Long: 13.41,
+		},
+	}
+
+	archiveParams = weather_service.MonitoringParamsMap{
+		enums.MonitoringParamTemperature: "temperature_2m",
+	}
+)
+
+func TestBackfillService_Backfill(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	var (
+		jan1  = date("2025-01-01")
+		jan5  = date("2025-01-05")
+		jan6  = date("2025-01-06")
+		jan10 = date("2025-01-10")
+		jan11 = date("2025-01-11")
+		jan12 = date("2025-01-12")
+
+		firstChunk = weather_service.CityWeatherConditions{
+			{City: berlin, CapturedAt: jan1, Temperature: ptr.To(enums.Celsius(-1.5))},
+		}
+		secondChunk = weather_service.CityWeatherConditions{
+			{City: berlin, CapturedAt: jan6, Temperature: ptr.To(enums.Celsius(2.5))},
+		}
+		thirdChunk = weather_service.CityWeatherConditions{
+			{City: berlin, CapturedAt: jan11, Temperature: ptr.To(enums.Celsius(0.5))},
+		}
+	)
+
+	tests := []struct {
+		name          string
+		req           backfill_service.Request
+		archiveClient func(ctrl *gomock.Controller) backfill_service.ArchiveClient
+		publisher     func(ctrl *gomock.Controller) backfill_service.Publisher
+		storage       func(ctrl *gomock.Controller) backfill_service.Storage
+		wantErrFunc   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "happy path in chunks",
+			req: backfill_service.Request{
+				City:      berlin,
+				From:      jan1,
+				To:        jan11,
+				ChunkDays: 5,
+			},
+			archiveClient: func(ctrl *gomock.Controller) backfill_service.ArchiveClient {
+				mock := NewMockArchiveClient(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().ArchiveWeather(gomock.Any(), berlin, archiveParams, jan1, jan5).Return(firstChunk, nil),
+					mock.EXPECT().ArchiveWeather(gomock.Any(), berlin, archiveParams, jan6, jan10).Return(secondChunk, nil),
+					mock.EXPECT().ArchiveWeather(gomock.Any(), berlin, archiveParams, jan11, jan11).Return(thirdChunk, nil),
+				)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) backfill_service.Publisher {
+				return nil
+			},
+			storage: func(ctrl *gomock.Controller) backfill_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetBackfillProgress(gomock.Any(), berlin.ID, jan1, jan11).
+					Return(backfill_service.Progress{}, false, nil)
+
+				gomock.InOrder(
+					mock.EXPECT().SaveBackfillChunk(gomock.Any(), progress(jan1, jan11, jan6), firstChunk).Return(nil),
+					mock.EXPECT().SaveBackfillChunk(gomock.Any(), progress(jan1, jan11, jan11), secondChunk).Return(nil),
+					mock.EXPECT().SaveBackfillChunk(gomock.Any(), progress(jan1, jan11, jan12), thirdChunk).Return(nil),
+				)
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "resumes from saved progress and publishes",
+			req: backfill_service.Request{
+				City:      berlin,
+				From:      jan1,
+				To:        jan10,
+				ChunkDays: 5,
+				Publish:   true,
+			},
+			archiveClient: func(ctrl *gomock.Controller) backfill_service.ArchiveClient {
+				mock := NewMockArchiveClient(ctrl)
+				mock.EXPECT().
+					ArchiveWeather(gomock.Any(), berlin, archiveParams, jan6, jan10).
+					Return(secondChunk, nil).
+					Times(1)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) backfill_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				mock.EXPECT().
+					PublishConditions(gomock.Any(), secondChunk).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			storage: func(ctrl *gomock.Controller) backfill_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetBackfillProgress(gomock.Any(), berlin.ID, jan1, jan10).
+					Return(progress(jan1, jan10, jan6), true, nil)
+
+				mock.EXPECT().
+					SaveBackfillChunk(gomock.Any(), progress(jan1, jan10, jan11), secondChunk).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "archive error keeps progress of stored chunks",
+			req: backfill_service.Request{
+				City:      berlin,
+				From:      jan1,
+				To:        jan10,
+				ChunkDays: 5,
+			},
+			archiveClient: func(ctrl *gomock.Controller) backfill_service.ArchiveClient {
+				mock := NewMockArchiveClient(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().ArchiveWeather(gomock.Any(), berlin, archiveParams, jan1, jan5).Return(firstChunk, nil),
+					mock.EXPECT().ArchiveWeather(gomock.Any(), berlin, archiveParams, jan6, jan10).Return(nil, errors.New("archive error")),
+				)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) backfill_service.Publisher {
+				return nil
+			},
+			storage: func(ctrl *gomock.Controller) backfill_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetBackfillProgress(gomock.Any(), berlin.ID, jan1, jan10).
+					Return(backfill_service.Progress{}, false, nil)
+
+				mock.EXPECT().
+					SaveBackfillChunk(gomock.Any(), progress(jan1, jan10, jan6), firstChunk).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			wantErrFunc: assert.Error,
+		},
+		{
+			name: "invalid range",
+			req: backfill_service.Request{
+				City:      berlin,
+				From:      jan10,
+				To:        jan1,
+				ChunkDays: 5,
+			},
+			archiveClient: func(ctrl *gomock.Controller) backfill_service.ArchiveClient {
+				return NewMockArchiveClient(ctrl)
+			},
+			publisher: func(ctrl *gomock.Controller) backfill_service.Publisher {
+				return nil
+			},
+			storage: func(ctrl *gomock.Controller) backfill_service.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			config := NewMockConfig(ctrl)
+			config.EXPECT().
+				ArchiveParams().
+				Return(archiveParams).
+				AnyTimes()
+
+			service := backfill_service.NewService(
+				config,
+				tt.archiveClient(ctrl),
+				tt.publisher(ctrl),
+				tt.storage(ctrl),
+			)
+
+			err := service.Backfill(context.Background(), tt.req)
+			if !tt.wantErrFunc(t, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func progress(from, to, next time.Time) backfill_service.Progress {
+	return backfill_service.Progress{
+		CityID:   berlin.ID,
+		From:     from,
+		To:       to,
+		NextDate: next,
+	}
+}
+
+func date(value string) time.Time {
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
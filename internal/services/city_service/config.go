@@ -0,0 +1,90 @@
+package city_service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	"github.com/meteogo/config/pkg/config"
+	"github.com/meteogo/logger/pkg/logger"
+	appconfig "github.com/meteogo/weather-collector-service/internal/config"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+var _ Config = &configImpl{}
+
+type Provider interface {
+	config.Provider
+}
+
+type configImpl struct {
+	seed Cities
+
+	mu sync.RWMutex
+}
+
+func NewConfig(provider Provider) (*configImpl, error) {
+	c := &configImpl{
+		seed: make(Cities, 0),
+
+		mu: sync.RWMutex{},
+	}
+
+	if err := c.updateSeed(provider.GetConfigClient().GetValue(appconfig.ReportedCities).String()); err != nil {
+		logger.Error(context.Background(), "unable to update reported cities value", slog.Any("error", err))
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// updateSeed reads the cities the table starts with. The list may be empty,
+// cities are then only added through the admin API. It is not reloaded while
+// the service runs, the seed only fills an empty table at start.
+func (c *configImpl) updateSeed(JSON string) error {
+	var cities []struct {
+		Name    string   `json:"name"`
+		Country string   `json:"country"`
+		Lat     float64  `json:"lat"`
+		Long    float64  `json:"long"`
+		Tags    []string `json:"tags"`
+	}
+	if err := json.Unmarshal([]byte(JSON), &cities); err != nil {
+		return err
+	}
+
+	seed := make(Cities, 0, len(cities))
+	for _, city := range cities {
+		seedCity := City{
+			Name:    city.Name,
+			Country: city.Country,
+			Coordinates: weather_service.Coordinates{
+				Lat:  city.Lat,
+				Long: city.Long,
+			},
+			Enabled: true,
+			Tags:    city.Tags,
+		}
+
+		if err := seedCity.Validate(); err != nil {
+			return err
+		}
+
+		seed = append(seed, seedCity)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.seed = seed
+	logger.Info(context.Background(), "updated reported cities value", slog.Int("seedCitiesCount", len(seed)))
+	return nil
+}
+
+func (c *configImpl) Seed() Cities {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.seed
+}
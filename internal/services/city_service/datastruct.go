@@ -0,0 +1,63 @@
+package city_service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+type (
+	City struct {
+		ID      int64
+		Name    string
+		Country string
+		weather_service.Coordinates
+		Enabled   bool
+		Tags      []string
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+
+	Cities []City
+)
+
+func (c City) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return fmt.Errorf("%w: name can not be empty", ErrInvalidCity)
+	}
+
+	if c.Lat < -90 || c.Lat > 90 {
+		return fmt.Errorf("%w: latitude of %q must be between -90 and 90", ErrInvalidCity, c.Name)
+	}
+
+	if c.Long < -180 || c.Long > 180 {
+		return fmt.Errorf("%w: longitude of %q must be between -180 and 180", ErrInvalidCity, c.Name)
+	}
+
+	for _, tag := range c.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return fmt.Errorf("%w: tags of %q can not be empty", ErrInvalidCity, c.Name)
+		}
+	}
+
+	return nil
+}
+
+// Reported returns the enabled cities, the ones the collector asks the
+// providers about.
+func (c Cities) Reported() weather_service.ReportedCities {
+	reported := make(weather_service.ReportedCities, 0, len(c))
+	for _, city := range c {
+		if city.Enabled {
+			reported = append(reported, weather_service.City{
+				ID:          city.ID,
+				Name:        city.Name,
+				Coordinates: city.Coordinates,
+			})
+		}
+	}
+
+	return reported
+}
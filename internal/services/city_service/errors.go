@@ -0,0 +1,11 @@
+package city_service
+
+import "errors"
+
+// The service and its storage wrap client mistakes in these errors so the
+// transports can tell them from a failing database.
+var (
+	ErrCityNotFound = errors.New("city not found")
+	ErrCityExists   = errors.New("city with this name already exists in the country")
+	ErrInvalidCity  = errors.New("invalid city")
+)
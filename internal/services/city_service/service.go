@@ -0,0 +1,173 @@
+package city_service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"go.opentelemetry.io/otel"
+)
+
+//go:generate mockgen -source service.go -destination service_mocks_test.go -package city_service_test -typed
+
+type Config interface {
+	Seed() Cities
+}
+
+type Storage interface {
+	ListCities(ctx context.Context) (Cities, error)
+	CreateCity(ctx context.Context, city City) (City, error)
+	UpdateCity(ctx context.Context, city City) (City, error)
+	SetCityEnabled(ctx context.Context, id int64, enabled bool) (City, error)
+	DeleteCity(ctx context.Context, id int64) error
+	SeedCities(ctx context.Context, cities Cities) (int64, error)
+}
+
+// Service manages the cities table and keeps the enabled cities in memory
+// for the collector. Changes made through this instance apply right away,
+// the ones made through other instances once Refresh runs.
+type Service struct {
+	config  Config
+	storage Storage
+
+	reported weather_service.ReportedCities
+	mu       sync.RWMutex
+}
+
+func NewService(config Config, storage Storage) *Service {
+	return &Service{
+		config:   config,
+		storage:  storage,
+		reported: make(weather_service.ReportedCities, 0),
+	}
+}
+
+// Seed fills the cities table from the config when it is empty. Once cities
+// are managed through the admin API the config list is no longer applied,
+// so deleted cities do not come back with the next start.
+func (s *Service) Seed(ctx context.Context) error {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.Seed]", s))
+	defer span.End()
+
+	seed := s.config.Seed()
+	if len(seed) == 0 {
+		return nil
+	}
+
+	inserted, err := s.storage.SeedCities(spanCtx, seed)
+	if err != nil {
+		return telemetry.Fail(span, err)
+	}
+
+	if inserted > 0 {
+		logger.Info(ctx, "seeded cities from config", slog.Int64("inserted", inserted))
+	}
+
+	return nil
+}
+
+// Refresh reloads the enabled cities from the storage.
+func (s *Service) Refresh(ctx context.Context) error {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.Refresh]", s))
+	defer span.End()
+
+	cities, err := s.storage.ListCities(spanCtx)
+	if err != nil {
+		return telemetry.Fail(span, err)
+	}
+
+	reported := cities.Reported()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reported = reported
+	logger.Debug(ctx, "refreshed reported cities", slog.Int("enabled", len(reported)), slog.Int("total", len(cities)))
+	return nil
+}
+
+func (s *Service) ReportedCities() weather_service.ReportedCities {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.reported
+}
+
+func (s *Service) Cities(ctx context.Context) (Cities, error) {
+	return s.storage.ListCities(ctx)
+}
+
+func (s *Service) CreateCity(ctx context.Context, city City) (City, error) {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.CreateCity]", s))
+	defer span.End()
+
+	if err := city.Validate(); err != nil {
+		return City{}, telemetry.Fail(span, err)
+	}
+
+	created, err := s.storage.CreateCity(spanCtx, city)
+	if err != nil {
+		return City{}, telemetry.Fail(span, err)
+	}
+
+	s.refreshAfterChange(spanCtx)
+	return created, nil
+}
+
+// UpdateCity replaces every editable field of the city with the given ID.
+func (s *Service) UpdateCity(ctx context.Context, city City) (City, error) {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.UpdateCity]", s))
+	defer span.End()
+
+	if err := city.Validate(); err != nil {
+		return City{}, telemetry.Fail(span, err)
+	}
+
+	updated, err := s.storage.UpdateCity(spanCtx, city)
+	if err != nil {
+		return City{}, telemetry.Fail(span, err)
+	}
+
+	s.refreshAfterChange(spanCtx)
+	return updated, nil
+}
+
+// DisableCity stops collecting the city but keeps it and its readings.
+func (s *Service) DisableCity(ctx context.Context, id int64) (City, error) {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.DisableCity]", s))
+	defer span.End()
+
+	disabled, err := s.storage.SetCityEnabled(spanCtx, id, false)
+	if err != nil {
+		return City{}, telemetry.Fail(span, err)
+	}
+
+	s.refreshAfterChange(spanCtx)
+	return disabled, nil
+}
+
+// DeleteCity removes the city. Its stored readings are kept.
+func (s *Service) DeleteCity(ctx context.Context, id int64) error {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.DeleteCity]", s))
+	defer span.End()
+
+	if err := s.storage.DeleteCity(spanCtx, id); err != nil {
+		return telemetry.Fail(span, err)
+	}
+
+	s.refreshAfterChange(spanCtx)
+	return nil
+}
+
+// refreshAfterChange applies a change to the collected cities right away.
+// The change is already stored, a failed refresh is caught up by the next
+// periodic one and does not fail the request.
+func (s *Service) refreshAfterChange(ctx context.Context) {
+	if err := s.Refresh(ctx); err != nil {
+		logger.Warn(ctx, "unable to refresh cities after change", slog.Any("error", err))
+	}
+}
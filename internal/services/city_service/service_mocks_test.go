@@ -0,0 +1,337 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source service.go -destination service_mocks_test.go -package city_service_test -typed
+//
+
+// Package city_service_test is a generated GoMock package.
+package city_service_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	city_service "github.com/meteogo/weather-collector-service/internal/services/city_service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfig is a mock of Config interface.
+type MockConfig struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigMockRecorder
+	isgomock struct{}
+}
+
+// MockConfigMockRecorder is the mock recorder for MockConfig.
+type MockConfigMockRecorder struct {
+	mock *MockConfig
+}
+
+// NewMockConfig creates a new mock instance.
+func NewMockConfig(ctrl *gomock.Controller) *MockConfig {
+	mock := &MockConfig{ctrl: ctrl}
+	mock.recorder = &MockConfigMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfig) EXPECT() *MockConfigMockRecorder {
+	return m.recorder
+}
+
+// Seed mocks base method.
+func (m *MockConfig) Seed() city_service.Cities {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seed")
+	ret0, _ := ret[0].(city_service.Cities)
+	return ret0
+}
+
+// Seed indicates an expected call of Seed.
+func (mr *MockConfigMockRecorder) Seed() *MockConfigSeedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seed", reflect.TypeOf((*MockConfig)(nil).Seed))
+	return &MockConfigSeedCall{Call: call}
+}
+
+// MockConfigSeedCall wrap *gomock.Call
+type MockConfigSeedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigSeedCall) Return(arg0 city_service.Cities) *MockConfigSeedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigSeedCall) Do(f func() city_service.Cities) *MockConfigSeedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigSeedCall) DoAndReturn(f func() city_service.Cities) *MockConfigSeedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateCity mocks base method.
+func (m *MockStorage) CreateCity(ctx context.Context, city city_service.City) (city_service.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", ctx, city)
+	ret0, _ := ret[0].(city_service.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockStorageMockRecorder) CreateCity(ctx, city any) *MockStorageCreateCityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockStorage)(nil).CreateCity), ctx, city)
+	return &MockStorageCreateCityCall{Call: call}
+}
+
+// MockStorageCreateCityCall wrap *gomock.Call
+type MockStorageCreateCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageCreateCityCall) Return(arg0 city_service.City, arg1 error) *MockStorageCreateCityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageCreateCityCall) Do(f func(context.Context, city_service.City) (city_service.City, error)) *MockStorageCreateCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageCreateCityCall) DoAndReturn(f func(context.Context, city_service.City) (city_service.City, error)) *MockStorageCreateCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCity mocks base method.
+func (m *MockStorage) DeleteCity(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCity", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCity indicates an expected call of DeleteCity.
+func (mr *MockStorageMockRecorder) DeleteCity(ctx, id any) *MockStorageDeleteCityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCity", reflect.TypeOf((*MockStorage)(nil).DeleteCity), ctx, id)
+	return &MockStorageDeleteCityCall{Call: call}
+}
+
+// MockStorageDeleteCityCall wrap *gomock.Call
+type MockStorageDeleteCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageDeleteCityCall) Return(arg0 error) *MockStorageDeleteCityCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageDeleteCityCall) Do(f func(context.Context, int64) error) *MockStorageDeleteCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageDeleteCityCall) DoAndReturn(f func(context.Context, int64) error) *MockStorageDeleteCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListCities mocks base method.
+func (m *MockStorage) ListCities(ctx context.Context) (city_service.Cities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCities", ctx)
+	ret0, _ := ret[0].(city_service.Cities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCities indicates an expected call of ListCities.
+func (mr *MockStorageMockRecorder) ListCities(ctx any) *MockStorageListCitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCities", reflect.TypeOf((*MockStorage)(nil).ListCities), ctx)
+	return &MockStorageListCitiesCall{Call: call}
+}
+
+// MockStorageListCitiesCall wrap *gomock.Call
+type MockStorageListCitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageListCitiesCall) Return(arg0 city_service.Cities, arg1 error) *MockStorageListCitiesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageListCitiesCall) Do(f func(context.Context) (city_service.Cities, error)) *MockStorageListCitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageListCitiesCall) DoAndReturn(f func(context.Context) (city_service.Cities, error)) *MockStorageListCitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SeedCities mocks base method.
+func (m *MockStorage) SeedCities(ctx context.Context, cities city_service.Cities) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedCities", ctx, cities)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeedCities indicates an expected call of SeedCities.
+func (mr *MockStorageMockRecorder) SeedCities(ctx, cities any) *MockStorageSeedCitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedCities", reflect.TypeOf((*MockStorage)(nil).SeedCities), ctx, cities)
+	return &MockStorageSeedCitiesCall{Call: call}
+}
+
+// MockStorageSeedCitiesCall wrap *gomock.Call
+type MockStorageSeedCitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSeedCitiesCall) Return(arg0 int64, arg1 error) *MockStorageSeedCitiesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSeedCitiesCall) Do(f func(context.Context, city_service.Cities) (int64, error)) *MockStorageSeedCitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSeedCitiesCall) DoAndReturn(f func(context.Context, city_service.Cities) (int64, error)) *MockStorageSeedCitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetCityEnabled mocks base method.
+func (m *MockStorage) SetCityEnabled(ctx context.Context, id int64, enabled bool) (city_service.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCityEnabled", ctx, id, enabled)
+	ret0, _ := ret[0].(city_service.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCityEnabled indicates an expected call of SetCityEnabled.
+func (mr *MockStorageMockRecorder) SetCityEnabled(ctx, id, enabled any) *MockStorageSetCityEnabledCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCityEnabled", reflect.TypeOf((*MockStorage)(nil).SetCityEnabled), ctx, id, enabled)
+	return &MockStorageSetCityEnabledCall{Call: call}
+}
+
+// MockStorageSetCityEnabledCall wrap *gomock.Call
+type MockStorageSetCityEnabledCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSetCityEnabledCall) Return(arg0 city_service.City, arg1 error) *MockStorageSetCityEnabledCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSetCityEnabledCall) Do(f func(context.Context, int64, bool) (city_service.City, error)) *MockStorageSetCityEnabledCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSetCityEnabledCall) DoAndReturn(f func(context.Context, int64, bool) (city_service.City, error)) *MockStorageSetCityEnabledCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateCity mocks base method.
+func (m *MockStorage) UpdateCity(ctx context.Context, city city_service.City) (city_service.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", ctx, city)
+	ret0, _ := ret[0].(city_service.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockStorageMockRecorder) UpdateCity(ctx, city any) *MockStorageUpdateCityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockStorage)(nil).UpdateCity), ctx, city)
+	return &MockStorageUpdateCityCall{Call: call}
+}
+
+// MockStorageUpdateCityCall wrap *gomock.Call
+type MockStorageUpdateCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageUpdateCityCall) Return(arg0 city_service.City, arg1 error) *MockStorageUpdateCityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageUpdateCityCall) Do(f func(context.Context, city_service.City) (city_service.City, error)) *MockStorageUpdateCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageUpdateCityCall) DoAndReturn(f func(context.Context, city_service.City) (city_service.City, error)) *MockStorageUpdateCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,245 @@
+package city_service_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/services/city_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	berlin = city_service.City{
+		ID:          1,
+		Name:        "Berlin",
+		Country:     "DE",
+		Coordinates: weather_service.Coordinates{Lat: 52.52, Long: 13.41},
+		Enabled:     true,
+		Tags:        []string{"capital"},
+	}
+
+	paris = city_service.City{
+		ID:          2,
+		Name:        "Paris",
+		Country:     "FR",
+		Coordinates: weather_service.Coordinates{Lat: 48.86, Long: 2.35},
+		Enabled:     false,
+	}
+)
+
+func TestService_Seed(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	tests := []struct {
+		name        string
+		seed        city_service.Cities
+		storage     func(ctrl *gomock.Controller) city_service.Storage
+		wantErrFunc assert.ErrorAssertionFunc
+	}{
+		{
+			name: "seeds the config cities",
+			seed: city_service.Cities{berlin},
+			storage: func(ctrl *gomock.Controller) city_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SeedCities(gomock.Any(), city_service.Cities{berlin}).
+					Return(int64(1), nil).
+					Times(1)
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "empty seed",
+			storage: func(ctrl *gomock.Controller) city_service.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "storage error",
+			seed: city_service.Cities{berlin},
+			storage: func(ctrl *gomock.Controller) city_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SeedCities(gomock.Any(), gomock.Any()).
+					Return(int64(0), errors.New("connection refused")).
+					Times(1)
+
+				return mock
+			},
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			config := NewMockConfig(ctrl)
+			config.EXPECT().
+				Seed().
+				Return(tt.seed).
+				Times(1)
+
+			service := city_service.NewService(config, tt.storage(ctrl))
+			tt.wantErrFunc(t, service.Seed(context.Background()))
+		})
+	}
+}
+
+func TestService_ReportedCities(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	ctrl := gomock.NewController(t)
+	storage := NewMockStorage(ctrl)
+	service := city_service.NewService(NewMockConfig(ctrl), storage)
+
+	assert.Empty(t, service.ReportedCities())
+
+	storage.EXPECT().
+		ListCities(gomock.Any()).
+		Return(city_service.Cities{berlin, paris}, nil).
+		Times(1)
+
+	require.NoError(t, service.Refresh(context.Background()))
+	assert.Equal(t, weather_service.ReportedCities{
+		{ID: berlin.ID, Name: "Berlin", Coordinates: berlin.Coordinates},
+	}, service.ReportedCities())
+
+	t.Run("failed refresh keeps the cities", func(t *testing.T) {
+		storage.EXPECT().
+			ListCities(gomock.Any()).
+			Return(nil, errors.New("connection refused")).
+			Times(1)
+
+		require.Error(t, service.Refresh(context.Background()))
+		assert.Len(t, service.ReportedCities(), 1)
+	})
+
+	t.Run("changes apply right away", func(t *testing.T) {
+		disabled := berlin
+		disabled.Enabled = false
+
+		storage.EXPECT().
+			SetCityEnabled(gomock.Any(), berlin.ID, false).
+			Return(disabled, nil).
+			Times(1)
+		storage.EXPECT().
+			ListCities(gomock.Any()).
+			Return(city_service.Cities{disabled, paris}, nil).
+			Times(1)
+
+		city, err := service.DisableCity(context.Background(), berlin.ID)
+		require.NoError(t, err)
+		assert.False(t, city.Enabled)
+		assert.Empty(t, service.ReportedCities())
+	})
+}
+
+func TestService_CreateCity(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	tests := []struct {
+		name        string
+		city        city_service.City
+		storage     func(ctrl *gomock.Controller) city_service.Storage
+		wantErrFunc assert.ErrorAssertionFunc
+	}{
+		{
+			name: "created",
+			city: berlin,
+			storage: func(ctrl *gomock.Controller) city_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					CreateCity(gomock.Any(), berlin).
+					Return(berlin, nil).
+					Times(1)
+				mock.EXPECT().
+					ListCities(gomock.Any()).
+					Return(city_service.Cities{berlin}, nil).
+					Times(1)
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "created although the refresh failed",
+			city: berlin,
+			storage: func(ctrl *gomock.Controller) city_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					CreateCity(gomock.Any(), berlin).
+					Return(berlin, nil).
+					Times(1)
+				mock.EXPECT().
+					ListCities(gomock.Any()).
+					Return(nil, errors.New("connection refused")).
+					Times(1)
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "empty name",
+			city: city_service.City{Coordinates: berlin.Coordinates},
+			storage: func(ctrl *gomock.Controller) city_service.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantErrFunc: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, city_service.ErrInvalidCity)
+			},
+		},
+		{
+			name: "latitude out of range",
+			city: city_service.City{Name: "Nowhere", Coordinates: weather_service.Coordinates{Lat: 91}},
+			storage: func(ctrl *gomock.Controller) city_service.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantErrFunc: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, city_service.ErrInvalidCity)
+			},
+		},
+		{
+			name: "duplicate name",
+			city: berlin,
+			storage: func(ctrl *gomock.Controller) city_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					CreateCity(gomock.Any(), berlin).
+					Return(city_service.City{}, city_service.ErrCityExists).
+					Times(1)
+
+				return mock
+			},
+			wantErrFunc: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, city_service.ErrCityExists)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			service := city_service.NewService(NewMockConfig(ctrl), tt.storage(ctrl))
+
+			_, err := service.CreateCity(context.Background(), tt.city)
+			tt.wantErrFunc(t, err)
+		})
+	}
+}
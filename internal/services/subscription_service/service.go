@@ -0,0 +1,131 @@
+package subscription_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"go.opentelemetry.io/otel"
+)
+
+// ErrSlowSubscriber ends a subscription whose buffer is full. Dropping it
+// keeps one stalled consumer from holding back the collection and the other
+// subscribers.
+var ErrSlowSubscriber = errors.New("subscriber does not keep up with the updates")
+
+// Subscription receives the new readings of its cities on Updates. The
+// channel is closed when the subscription is closed or dropped, Err tells
+// which one happened.
+type Subscription struct {
+	updates chan weather_service.CityWeatherCondition
+	cityIDs []int64
+	err     error
+	service *Service
+}
+
+func (s *Subscription) Updates() <-chan weather_service.CityWeatherCondition {
+	return s.updates
+}
+
+// Err returns ErrSlowSubscriber once the subscription was dropped.
+func (s *Subscription) Err() error {
+	s.service.mu.Lock()
+	defer s.service.mu.Unlock()
+
+	return s.err
+}
+
+func (s *Subscription) Close() {
+	s.service.remove(s, nil)
+}
+
+func (s *Subscription) follows(cityID int64) bool {
+	return len(s.cityIDs) == 0 || slices.Contains(s.cityIDs, cityID)
+}
+
+// Service fans the collected readings out to in-process subscribers, the
+// readings collected by other replicas never reach them. A reading is only
+// sent once per city, the collector asks more often than providers refresh
+// their data. Cities are told apart and followed by id.
+type Service struct {
+	buffer int
+
+	subscribers map[*Subscription]struct{}
+	capturedAt  map[int64]time.Time
+	mu          sync.Mutex
+}
+
+func NewService(buffer int) *Service {
+	return &Service{
+		buffer:      buffer,
+		subscribers: make(map[*Subscription]struct{}),
+		capturedAt:  make(map[int64]time.Time),
+	}
+}
+
+// Subscribe follows the cities with the given ids, every city when none is
+// given.
+func (s *Service) Subscribe(cityIDs []int64) *Subscription {
+	sub := &Subscription{
+		updates: make(chan weather_service.CityWeatherCondition, s.buffer),
+		cityIDs: cityIDs,
+		service: s,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (s *Service) Broadcast(ctx context.Context, conditions weather_service.CityWeatherConditions) {
+	_, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.Broadcast]", s))
+	defer span.End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, condition := range conditions {
+		if !condition.CapturedAt.After(s.capturedAt[condition.City.ID]) {
+			continue
+		}
+		s.capturedAt[condition.City.ID] = condition.CapturedAt
+
+		for sub := range s.subscribers {
+			if !sub.follows(condition.City.ID) {
+				continue
+			}
+
+			select {
+			case sub.updates <- condition:
+			default:
+				logger.Warn(ctx, "dropping slow subscriber", slog.Int("buffer", s.buffer))
+				s.removeLocked(sub, ErrSlowSubscriber)
+			}
+		}
+	}
+}
+
+func (s *Service) remove(sub *Subscription, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeLocked(sub, err)
+}
+
+func (s *Service) removeLocked(sub *Subscription, err error) {
+	if _, ok := s.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(s.subscribers, sub)
+	sub.err = err
+	close(sub.updates)
+}
@@ -0,0 +1,97 @@
+package subscription_service_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/services/subscription_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var capturedAt = time.Date(2025, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func condition(cityID int64, city string, capturedAt time.Time) weather_service.CityWeatherCondition {
+	return weather_service.CityWeatherCondition{
+		City:       weather_service.City{ID: cityID, Name: city},
+		CapturedAt: capturedAt,
+	}
+}
+
+func drain(sub *subscription_service.Subscription) []weather_service.CityWeatherCondition {
+	var conditions []weather_service.CityWeatherCondition
+	for {
+		select {
+		case c, ok := <-sub.Updates():
+			if !ok {
+				return conditions
+			}
+			conditions = append(conditions, c)
+		default:
+			return conditions
+		}
+	}
+}
+
+func TestService_Broadcast(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	ctx := context.Background()
+	service := subscription_service.NewService(3)
+
+	berlin := service.Subscribe([]int64{1})
+	all := service.Subscribe(nil)
+	defer all.Close()
+
+	service.Broadcast(ctx, weather_service.CityWeatherConditions{
+		condition(1, "Berlin", capturedAt),
+		condition(2, "Paris", capturedAt),
+		condition(3, "Berlin", capturedAt),
+	})
+	service.Broadcast(ctx, weather_service.CityWeatherConditions{
+		condition(1, "Berlin", capturedAt),
+	})
+
+	assert.Equal(t, []weather_service.CityWeatherCondition{condition(1, "Berlin", capturedAt)}, drain(berlin))
+	assert.Len(t, drain(all), 3)
+
+	berlin.Close()
+	service.Broadcast(ctx, weather_service.CityWeatherConditions{
+		condition(1, "Berlin", capturedAt.Add(time.Hour)),
+	})
+
+	_, ok := <-berlin.Updates()
+	assert.False(t, ok)
+	assert.NoError(t, berlin.Err())
+	assert.Len(t, drain(all), 1)
+}
+
+func TestService_BroadcastDropsSlowSubscriber(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	ctx := context.Background()
+	service := subscription_service.NewService(1)
+
+	slow := service.Subscribe(nil)
+	fast := service.Subscribe(nil)
+	defer fast.Close()
+
+	service.Broadcast(ctx, weather_service.CityWeatherConditions{condition(1, "Berlin", capturedAt)})
+	require.Len(t, drain(fast), 1)
+
+	service.Broadcast(ctx, weather_service.CityWeatherConditions{condition(2, "Paris", capturedAt)})
+	require.Len(t, drain(fast), 1)
+
+	assert.Len(t, drain(slow), 1)
+	_, ok := <-slow.Updates()
+	assert.False(t, ok)
+	assert.ErrorIs(t, slow.Err(), subscription_service.ErrSlowSubscriber)
+
+	slow.Close()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"sync"
 
@@ -17,6 +18,9 @@ import (
 
 var _ Config = &configImpl{}
 
+// maxForecastDays is the longest horizon Open-Meteo serves from the forecast endpoint.
+const maxForecastDays = 16
+
 type Provider interface {
 	config.Provider
 }
@@ -29,78 +33,157 @@ type Value interface {
 	config.Value
 }
 
+// CitySource provides the cities to collect, it is backed by the cities
+// table.
+type CitySource interface {
+	ReportedCities() ReportedCities
+}
+
 type configImpl struct {
-	reportedCities   ReportedCities
-	monitoringParams MonitoringParamsMap
-	workerPoolSize   int
+	cities            CitySource
+	registry          ProviderRegistry
+	monitoringParams  MonitoringParamsMap
+	workerPoolSize    int
+	forecastDays      int
+	dailyParams       DailyParamsMap
+	outboxBatchSize   int
+	outboxMaxAttempts int
+	providers         []enums.Provider
+	batchSize         int
 
 	mu sync.RWMutex
 }
 
-func NewConfig(provider Provider) (*configImpl, error) {
+// NewConfig reads the values of provider. The weather providers it names
+// must be registered in registry.
+func NewConfig(provider Provider, cities CitySource, registry ProviderRegistry) (*configImpl, error) {
 	c := &configImpl{
-		reportedCities:   make(ReportedCities, 0),
-		monitoringParams: make(MonitoringParamsMap),
-		workerPoolSize:   0,
+		cities:            cities,
+		registry:          registry,
+		monitoringParams:  make(MonitoringParamsMap),
+		workerPoolSize:    0,
+		forecastDays:      0,
+		dailyParams:       make(DailyParamsMap),
+		outboxBatchSize:   0,
+		outboxMaxAttempts: 0,
+		providers:         make([]enums.Provider, 0),
+		batchSize:         0,
 
 		mu: sync.RWMutex{},
 	}
 
-	if err := c.updateReportedCities(provider.GetConfigClient().GetValue(appconfig.ReportedCities).String()); err != nil {
-		logger.Error(context.Background(), "unable to update reported cities value", slog.Any("error", err))
+	apply, err := c.Prepare(provider)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := c.updateMonitoringParams(provider.GetConfigClient().GetValue(appconfig.MonitoringParams).String()); err != nil {
+	apply()
+	return c, nil
+}
+
+// values is a validated set of config values, they are applied together.
+type values struct {
+	monitoringParams  MonitoringParamsMap
+	workerPoolSize    int
+	forecastDays      int
+	dailyParams       DailyParamsMap
+	outboxBatchSize   int
+	outboxMaxAttempts int
+	providers         []enums.Provider
+	batchSize         int
+}
+
+// Prepare validates the values of provider and returns the function that
+// applies them. Nothing is applied when a value is invalid, every invalid
+// value is reported. The reported cities come from the cities table and are
+// refreshed separately.
+func (c *configImpl) Prepare(provider Provider) (func(), error) {
+	var (
+		v    values
+		err  error
+		errs []error
+	)
+
+	if v.monitoringParams, err = parseMonitoringParams(provider.GetConfigClient().GetValue(appconfig.MonitoringParams).String()); err != nil {
 		logger.Error(context.Background(), "unable to update monitoring params value", slog.Any("error", err))
-		return nil, err
+		errs = append(errs, err)
 	}
 
-	if err := c.updateWorkerPoolSize(provider.GetConfigClient().GetValue(appconfig.CollectorWorkerPoolSize).Int()); err != nil {
+	if v.workerPoolSize, err = parseWorkerPoolSize(provider.GetConfigClient().GetValue(appconfig.CollectorWorkerPoolSize).Int()); err != nil {
 		logger.Error(context.Background(), "unable to update worker pool size value", slog.Any("error", err))
-		return nil, err
+		errs = append(errs, err)
 	}
 
-	return c, nil
-}
+	if v.forecastDays, err = parseForecastDays(provider.GetConfigClient().GetValue(appconfig.ForecastDays).Int()); err != nil {
+		logger.Error(context.Background(), "unable to update forecast days value", slog.Any("error", err))
+		errs = append(errs, err)
+	}
 
-func (c *configImpl) updateReportedCities(JSON string) error {
-	var cities []struct {
-		Name string  `json:"name"`
-		Lat  float64 `json:"lat"`
-		Long float64 `json:"long"`
+	if v.dailyParams, err = parseDailyParams(provider.GetConfigClient().GetValue(appconfig.ForecastDailyParams).String()); err != nil {
+		logger.Error(context.Background(), "unable to update forecast daily params value", slog.Any("error", err))
+		errs = append(errs, err)
 	}
-	if err := json.Unmarshal([]byte(JSON), &cities); err != nil {
-		return err
+
+	if v.outboxBatchSize, err = parseOutboxBatchSize(provider.GetConfigClient().GetValue(appconfig.OutboxBatchSize).Int()); err != nil {
+		logger.Error(context.Background(), "unable to update outbox batch size value", slog.Any("error", err))
+		errs = append(errs, err)
+	}
+
+	if v.outboxMaxAttempts, err = parseOutboxMaxAttempts(provider.GetConfigClient().GetValue(appconfig.OutboxMaxAttempts).Int()); err != nil {
+		logger.Error(context.Background(), "unable to update outbox max attempts value", slog.Any("error", err))
+		errs = append(errs, err)
 	}
 
-	reportedCities := make(ReportedCities, 0)
-	for _, city := range cities {
-		reportedCities = append(reportedCities, City{
-			Name: city.Name,
-			Coordinates: Coordinates{
-				Lat:  city.Lat,
-				Long: city.Long,
-			},
-		})
+	if v.providers, err = c.parseProviders(provider.GetConfigClient().GetValue(appconfig.WeatherProviders).String()); err != nil {
+		logger.Error(context.Background(), "unable to update weather providers value", slog.Any("error", err))
+		errs = append(errs, err)
 	}
 
-	if len(reportedCities) == 0 {
-		return errors.New("size of reported cities can not be zero")
+	if v.batchSize, err = parseBatchSize(provider.GetConfigClient().GetValue(appconfig.CollectorBatchSize).Int()); err != nil {
+		logger.Error(context.Background(), "unable to update batch size value", slog.Any("error", err))
+		errs = append(errs, err)
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return func() { c.update(v) }, nil
+}
+
+func (c *configImpl) update(v values) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.reportedCities = reportedCities
-	logger.Info(context.Background(), "updated reported cities value", slog.Any(string(appconfig.ReportedCities), reportedCities))
-	return nil
+	c.monitoringParams = v.monitoringParams
+	logger.Info(context.Background(), "updated monitoring params value", slog.Any(string(appconfig.MonitoringParams), v.monitoringParams))
+
+	c.workerPoolSize = v.workerPoolSize
+	logger.Info(context.Background(), "updated worker pool size value", slog.Int(string(appconfig.CollectorWorkerPoolSize), v.workerPoolSize))
+
+	c.forecastDays = v.forecastDays
+	logger.Info(context.Background(), "updated forecast days value", slog.Int(string(appconfig.ForecastDays), v.forecastDays))
+
+	c.dailyParams = v.dailyParams
+	logger.Info(context.Background(), "updated forecast daily params value", slog.Any(string(appconfig.ForecastDailyParams), v.dailyParams))
+
+	c.outboxBatchSize = v.outboxBatchSize
+	logger.Info(context.Background(), "updated outbox batch size value", slog.Int(string(appconfig.OutboxBatchSize), v.outboxBatchSize))
+
+	c.outboxMaxAttempts = v.outboxMaxAttempts
+	logger.Info(context.Background(), "updated outbox max attempts value", slog.Int(string(appconfig.OutboxMaxAttempts), v.outboxMaxAttempts))
+
+	c.providers = v.providers
+	logger.Info(context.Background(), "updated weather providers value", slog.Any(string(appconfig.WeatherProviders), v.providers))
+
+	c.batchSize = v.batchSize
+	logger.Info(context.Background(), "updated batch size value", slog.Int(string(appconfig.CollectorBatchSize), v.batchSize))
 }
 
-func (c *configImpl) updateMonitoringParams(JSON string) error {
+func parseMonitoringParams(JSON string) (MonitoringParamsMap, error) {
 	params := make(map[string]string)
 	if err := json.Unmarshal([]byte(JSON), &params); err != nil {
-		return err
+		return nil, err
 	}
 
 	monitoringParams := make(MonitoringParamsMap)
@@ -109,35 +192,102 @@ func (c *configImpl) updateMonitoringParams(JSON string) error {
 	}
 
 	if len(monitoringParams) == 0 {
-		return errors.New("size of monitoring params can not be zero")
+		return nil, errors.New("size of monitoring params can not be zero")
 	}
 
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	if err := monitoringParams.Validate(); err != nil {
+		return nil, err
+	}
 
-	c.monitoringParams = monitoringParams
-	logger.Info(context.Background(), "updated monitoring params value", slog.Any(string(appconfig.MonitoringParams), monitoringParams))
-	return nil
+	return monitoringParams, nil
 }
 
-func (c *configImpl) updateWorkerPoolSize(wps int) error {
+func parseWorkerPoolSize(wps int) (int, error) {
 	if wps < 1 {
-		return errors.New("worker pool size value in config can not be less than 1")
+		return 0, errors.New("worker pool size value in config can not be less than 1")
 	}
 
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	return wps, nil
+}
+
+func parseForecastDays(days int) (int, error) {
+	if days < 1 || days > maxForecastDays {
+		return 0, fmt.Errorf("forecast days value in config must be between 1 and %d", maxForecastDays)
+	}
 
-	c.workerPoolSize = wps
-	logger.Info(context.Background(), "updated worker pool size value", slog.Int(string(appconfig.CollectorWorkerPoolSize), wps))
-	return nil
+	return days, nil
 }
 
-func (c *configImpl) ReportedCities() ReportedCities {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+func parseDailyParams(JSON string) (DailyParamsMap, error) {
+	params := make(map[string]string)
+	if err := json.Unmarshal([]byte(JSON), &params); err != nil {
+		return nil, err
+	}
+
+	dailyParams := make(DailyParamsMap)
+	for k, v := range params {
+		dailyParams[enums.DailyParam(k)] = v
+	}
+
+	if len(dailyParams) == 0 {
+		return nil, errors.New("size of forecast daily params can not be zero")
+	}
+
+	if err := dailyParams.Validate(); err != nil {
+		return nil, err
+	}
 
-	return c.reportedCities
+	return dailyParams, nil
+}
+
+func parseOutboxBatchSize(size int) (int, error) {
+	if size < 1 {
+		return 0, errors.New("outbox batch size value in config can not be less than 1")
+	}
+
+	return size, nil
+}
+
+func parseOutboxMaxAttempts(attempts int) (int, error) {
+	if attempts < 1 {
+		return 0, errors.New("outbox max attempts value in config can not be less than 1")
+	}
+
+	return attempts, nil
+}
+
+func (c *configImpl) parseProviders(JSON string) ([]enums.Provider, error) {
+	var names []string
+	if err := json.Unmarshal([]byte(JSON), &names); err != nil {
+		return nil, err
+	}
+
+	providers := make([]enums.Provider, 0, len(names))
+	for _, name := range names {
+		if _, ok := c.registry.Provider(enums.Provider(name)); !ok {
+			return nil, fmt.Errorf("weather provider %q is not registered", name)
+		}
+
+		providers = append(providers, enums.Provider(name))
+	}
+
+	if len(providers) == 0 {
+		return nil, errors.New("size of weather providers can not be zero")
+	}
+
+	return providers, nil
+}
+
+func parseBatchSize(size int) (int, error) {
+	if size < 1 {
+		return 0, errors.New("collector batch size value in config can not be less than 1")
+	}
+
+	return size, nil
+}
+
+func (c *configImpl) ReportedCities() ReportedCities {
+	return c.cities.ReportedCities()
 }
 
 func (c *configImpl) MonitoringParams() MonitoringParamsMap {
@@ -153,3 +303,42 @@ func (c *configImpl) WorkerPoolSize() int {
 
 	return c.workerPoolSize
 }
+
+func (c *configImpl) ForecastParams() ForecastParams {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return ForecastParams{
+		Hourly: c.monitoringParams,
+		Daily:  c.dailyParams,
+		Days:   c.forecastDays,
+	}
+}
+
+func (c *configImpl) OutboxBatchSize() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.outboxBatchSize
+}
+
+func (c *configImpl) OutboxMaxAttempts() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.outboxMaxAttempts
+}
+
+func (c *configImpl) Providers() []enums.Provider {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.providers
+}
+
+func (c *configImpl) BatchSize() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.batchSize
+}
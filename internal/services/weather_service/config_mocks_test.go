@@ -14,6 +14,7 @@ import (
 	time "time"
 
 	config "github.com/meteogo/config/pkg/config"
+	weather_service "github.com/meteogo/weather-collector-service/internal/services/weather_service"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -354,3 +355,65 @@ func (c *MockValueStringCall) DoAndReturn(f func() string) *MockValueStringCall
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockCitySource is a mock of CitySource interface.
+type MockCitySource struct {
+	ctrl     *gomock.Controller
+	recorder *MockCitySourceMockRecorder
+	isgomock struct{}
+}
+
+// MockCitySourceMockRecorder is the mock recorder for MockCitySource.
+type MockCitySourceMockRecorder struct {
+	mock *MockCitySource
+}
+
+// NewMockCitySource creates a new mock instance.
+func NewMockCitySource(ctrl *gomock.Controller) *MockCitySource {
+	mock := &MockCitySource{ctrl: ctrl}
+	mock.recorder = &MockCitySourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCitySource) EXPECT() *MockCitySourceMockRecorder {
+	return m.recorder
+}
+
+// ReportedCities mocks base method.
+func (m *MockCitySource) ReportedCities() weather_service.ReportedCities {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportedCities")
+	ret0, _ := ret[0].(weather_service.ReportedCities)
+	return ret0
+}
+
+// ReportedCities indicates an expected call of ReportedCities.
+func (mr *MockCitySourceMockRecorder) ReportedCities() *MockCitySourceReportedCitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportedCities", reflect.TypeOf((*MockCitySource)(nil).ReportedCities))
+	return &MockCitySourceReportedCitiesCall{Call: call}
+}
+
+// MockCitySourceReportedCitiesCall wrap *gomock.Call
+type MockCitySourceReportedCitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCitySourceReportedCitiesCall) Return(arg0 weather_service.ReportedCities) *MockCitySourceReportedCitiesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCitySourceReportedCitiesCall) Do(f func() weather_service.ReportedCities) *MockCitySourceReportedCitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCitySourceReportedCitiesCall) DoAndReturn(f func() weather_service.ReportedCities) *MockCitySourceReportedCitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -1,6 +1,7 @@
 package weather_service_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/meteogo/config/pkg/config"
@@ -8,6 +9,7 @@ import (
 	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
 	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -19,7 +21,15 @@ func TestWeatherCollectorConfig(t *testing.T) {
 		wantReportedCities   weather_service.ReportedCities
 		wantMonitoringParams weather_service.MonitoringParamsMap
 		wantWorkerPoolSize   int
+		wantForecastDays     int
+		wantDailyParams      weather_service.DailyParamsMap
+		wantOutboxBatchSize  int
+		wantOutboxAttempts   int
+		wantProviders        []enums.Provider
+		wantBatchSize        int
 		provider             func(ctrl *gomock.Controller) config.Provider
+		cities               func(ctrl *gomock.Controller) weather_service.CitySource
+		registry             func(ctrl *gomock.Controller) weather_service.ProviderRegistry
 		wantErrFunc          assert.ErrorAssertionFunc
 	}{
 		{
@@ -57,11 +67,69 @@ func TestWeatherCollectorConfig(t *testing.T) {
 				enums.MonitoringParamVisibility:       "visibility",
 			},
 			wantWorkerPoolSize: 10,
+			wantForecastDays:   7,
+			wantDailyParams: weather_service.DailyParamsMap{
+				enums.DailyParamTemperatureMax: "temperature_2m_max",
+				enums.DailyParamWeatherCode:    "weather_code",
+			},
+			wantOutboxBatchSize: 50,
+			wantOutboxAttempts:  5,
+			wantProviders:       []enums.Provider{enums.ProviderOpenMeteo, enums.ProviderMetNorway},
+			wantBatchSize:       25,
 			provider: func(ctrl *gomock.Controller) config.Provider {
-				return mockProvider(ctrl)
+				return mockProvider(ctrl, validValues)
+			},
+			cities: func(ctrl *gomock.Controller) weather_service.CitySource {
+				mock := NewMockCitySource(ctrl)
+				mock.EXPECT().
+					ReportedCities().
+					Return(weather_service.ReportedCities{
+						{
+							Name: "Berlin",
+							Coordinates: weather_service.Coordinates{
+								Lat:  52.52,
+								Long: 13.41,
+							},
+						},
+						{
+							Name: "Paris",
+							Coordinates: weather_service.Coordinates{
+								Lat:  48.86,
+								Long: 2.35,
+							},
+						},
+						{
+							Name: "London",
+							Coordinates: weather_service.Coordinates{
+								Lat:  51.51,
+								Long: -0.13,
+							},
+						},
+					}).
+					Times(1)
+
+				return mock
+			},
+			registry: func(ctrl *gomock.Controller) weather_service.ProviderRegistry {
+				return mockRegistry(ctrl, enums.ProviderOpenMeteo, enums.ProviderMetNorway)
 			},
 			wantErrFunc: assert.NoError,
 		},
+		{
+			name: "unregistered provider",
+			provider: func(ctrl *gomock.Controller) config.Provider {
+				return mockProvider(ctrl, validValues)
+			},
+			cities: func(ctrl *gomock.Controller) weather_service.CitySource {
+				return NewMockCitySource(ctrl)
+			},
+			registry: func(ctrl *gomock.Controller) weather_service.ProviderRegistry {
+				return mockRegistry(ctrl, enums.ProviderOpenMeteo)
+			},
+			wantErrFunc: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorContains(t, err, `weather provider "met_norway" is not registered`)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -69,19 +137,90 @@ func TestWeatherCollectorConfig(t *testing.T) {
 			t.Parallel()
 
 			ctrl := gomock.NewController(t)
-			cfg, err := weather_service.NewConfig(tt.provider(ctrl))
+			cfg, err := weather_service.NewConfig(tt.provider(ctrl), tt.cities(ctrl), tt.registry(ctrl))
 			if !tt.wantErrFunc(t, err) {
 				t.Fail()
 			}
 
+			if err != nil {
+				return
+			}
+
 			assert.Equal(t, tt.wantReportedCities, cfg.ReportedCities())
 			assert.Equal(t, tt.wantMonitoringParams, cfg.MonitoringParams())
 			assert.Equal(t, tt.wantWorkerPoolSize, cfg.WorkerPoolSize())
+			assert.Equal(t, weather_service.ForecastParams{
+				Hourly: tt.wantMonitoringParams,
+				Daily:  tt.wantDailyParams,
+				Days:   tt.wantForecastDays,
+			}, cfg.ForecastParams())
+			assert.Equal(t, tt.wantOutboxBatchSize, cfg.OutboxBatchSize())
+			assert.Equal(t, tt.wantOutboxAttempts, cfg.OutboxMaxAttempts())
+			assert.Equal(t, tt.wantProviders, cfg.Providers())
+			assert.Equal(t, tt.wantBatchSize, cfg.BatchSize())
 		})
 	}
 }
 
-func mockProvider(crtl *gomock.Controller) config.Provider {
+func TestWeatherCollectorConfig_Prepare(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	registry := mockRegistry(ctrl, enums.ProviderOpenMeteo, enums.ProviderMetNorway)
+	cfg, err := weather_service.NewConfig(mockProvider(ctrl, validValues), NewMockCitySource(ctrl), registry)
+	require.NoError(t, err)
+
+	assertValues := func(t *testing.T, want configValues) {
+		t.Helper()
+
+		assert.Equal(t, want.workerPoolSize, cfg.WorkerPoolSize())
+		assert.Equal(t, want.forecastDays, cfg.ForecastParams().Days)
+		assert.Equal(t, want.outboxBatchSize, cfg.OutboxBatchSize())
+		assert.Equal(t, want.batchSize, cfg.BatchSize())
+	}
+
+	t.Run("invalid value keeps every previous value", func(t *testing.T) {
+		_, err := cfg.Prepare(mockProvider(ctrl, configValues{workerPoolSize: 0, forecastDays: 3, outboxBatchSize: 5, batchSize: 5}))
+		assert.ErrorContains(t, err, "worker pool size value in config can not be less than 1")
+		assertValues(t, validValues)
+	})
+
+	t.Run("valid values are applied together", func(t *testing.T) {
+		reloaded := configValues{workerPoolSize: 20, forecastDays: 3, outboxBatchSize: 5, batchSize: 5}
+
+		apply, err := cfg.Prepare(mockProvider(ctrl, reloaded))
+		require.NoError(t, err)
+		assertValues(t, validValues)
+
+		apply()
+		assertValues(t, reloaded)
+	})
+}
+
+// mockRegistry knows the given providers only.
+func mockRegistry(ctrl *gomock.Controller, providers ...enums.Provider) weather_service.ProviderRegistry {
+	mock := NewMockProviderRegistry(ctrl)
+	mock.EXPECT().
+		Provider(gomock.Any()).
+		DoAndReturn(func(name enums.Provider) (weather_service.WeatherProvider, bool) {
+			return nil, slices.Contains(providers, name)
+		}).
+		AnyTimes()
+
+	return mock
+}
+
+// configValues are the numeric values mockProvider serves.
+type configValues struct {
+	workerPoolSize  int
+	forecastDays    int
+	outboxBatchSize int
+	batchSize       int
+}
+
+var validValues = configValues{workerPoolSize: 10, forecastDays: 7, outboxBatchSize: 50, batchSize: 25}
+
+func mockProvider(crtl *gomock.Controller, values configValues) config.Provider {
 	providerMock := NewMockProvider(crtl)
 	clientMock := NewMockConfigClient(crtl)
 
@@ -90,37 +229,6 @@ func mockProvider(crtl *gomock.Controller) config.Provider {
 		Return(clientMock).
 		AnyTimes()
 
-	{
-		reportedCitiesValueMock := NewMockValue(crtl)
-		reportedCitiesValueMock.EXPECT().
-			String().
-			Return(`
-			[
-				{
-					"name": "Berlin",
-					"lat": 52.52,
-					"long": 13.41
-				},
-				{
-					"name": "Paris",
-					"lat": 48.86,
-					"long": 2.35
-				},
-				{
-					"name": "London",
-					"lat": 51.51,
-					"long": -0.13
-				}
-			]
-			`).
-			Times(1)
-
-		clientMock.EXPECT().
-			GetValue(gomock.Eq(appconfig.ReportedCities)).
-			Return(reportedCitiesValueMock).
-			Times(1)
-	}
-
 	{
 		monitoringParamsValueMock := NewMockValue(crtl)
 		monitoringParamsValueMock.EXPECT().
@@ -148,7 +256,7 @@ func mockProvider(crtl *gomock.Controller) config.Provider {
 		workerPoolValueMock := NewMockValue(crtl)
 		workerPoolValueMock.EXPECT().
 			Int().
-			Return(10).
+			Return(values.workerPoolSize).
 			Times(1)
 
 		clientMock.EXPECT().
@@ -157,5 +265,88 @@ func mockProvider(crtl *gomock.Controller) config.Provider {
 			Times(1)
 	}
 
+	{
+		forecastDaysValueMock := NewMockValue(crtl)
+		forecastDaysValueMock.EXPECT().
+			Int().
+			Return(values.forecastDays).
+			Times(1)
+
+		clientMock.EXPECT().
+			GetValue(gomock.Eq(appconfig.ForecastDays)).
+			Return(forecastDaysValueMock).
+			Times(1)
+	}
+
+	{
+		dailyParamsValueMock := NewMockValue(crtl)
+		dailyParamsValueMock.EXPECT().
+			String().
+			Return(`
+			{
+				"temperatureMax": "temperature_2m_max",
+				"weatherCode": "weather_code"
+			}
+			`).
+			Times(1)
+
+		clientMock.EXPECT().
+			GetValue(gomock.Eq(appconfig.ForecastDailyParams)).
+			Return(dailyParamsValueMock).
+			Times(1)
+	}
+
+	{
+		outboxBatchSizeValueMock := NewMockValue(crtl)
+		outboxBatchSizeValueMock.EXPECT().
+			Int().
+			Return(values.outboxBatchSize).
+			Times(1)
+
+		clientMock.EXPECT().
+			GetValue(gomock.Eq(appconfig.OutboxBatchSize)).
+			Return(outboxBatchSizeValueMock).
+			Times(1)
+	}
+
+	{
+		outboxMaxAttemptsValueMock := NewMockValue(crtl)
+		outboxMaxAttemptsValueMock.EXPECT().
+			Int().
+			Return(5).
+			Times(1)
+
+		clientMock.EXPECT().
+			GetValue(gomock.Eq(appconfig.OutboxMaxAttempts)).
+			Return(outboxMaxAttemptsValueMock).
+			Times(1)
+	}
+
+	{
+		providersValueMock := NewMockValue(crtl)
+		providersValueMock.EXPECT().
+			String().
+			Return(`["open_meteo", "met_norway"]`).
+			Times(1)
+
+		clientMock.EXPECT().
+			GetValue(gomock.Eq(appconfig.WeatherProviders)).
+			Return(providersValueMock).
+			Times(1)
+	}
+
+	{
+		batchSizeValueMock := NewMockValue(crtl)
+		batchSizeValueMock.EXPECT().
+			Int().
+			Return(values.batchSize).
+			Times(1)
+
+		clientMock.EXPECT().
+			GetValue(gomock.Eq(appconfig.CollectorBatchSize)).
+			Return(batchSizeValueMock).
+			Times(1)
+	}
+
 	return providerMock
 }
@@ -1,6 +1,9 @@
 package weather_service
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"time"
 
 	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
@@ -12,25 +15,186 @@ type (
 		Long float64
 	}
 
+	// City is identified by ID, its id in the cities table. Readings,
+	// fingerprints and alert states are kept by the id, so renaming a city
+	// does not start its history over.
 	City struct {
+		ID   int64
 		Name string
 		Coordinates
 	}
 
 	ReportedCities      []City
 	MonitoringParamsMap map[enums.MonitoringParam]string
+	DailyParamsMap      map[enums.DailyParam]string
 
+	// CityWeatherCondition leaves a measurement nil when it was not requested
+	// or the provider did not report it.
 	CityWeatherCondition struct {
 		City                    City
 		CapturedAt              time.Time
-		Temperature             float64
-		RelativeHumidityPercent uint8
-		WindSpeed               float64
-		WeatherCode             enums.WeatherCode
-		CloudCoverPercent       uint8
-		Precipitation           enums.Length
-		Visibility              enums.Length
+		Temperature             *enums.Temperature
+		RelativeHumidityPercent *uint8
+		WindSpeed               *enums.Speed
+		WeatherCode             *enums.WeatherCode
+		CloudCoverPercent       *uint8
+		Precipitation           *enums.Length
+		Visibility              *enums.Length
+		Provider                enums.Provider
 	}
 
 	CityWeatherConditions []CityWeatherCondition
+
+	OutboxEntry struct {
+		ID        int64
+		Condition CityWeatherCondition
+		Attempts  int
+	}
+
+	OutboxEntries []OutboxEntry
+
+	// Fingerprints holds the fingerprint of the last published condition of
+	// every city, keyed by city id.
+	Fingerprints map[int64]string
+
+	ForecastParams struct {
+		Hourly MonitoringParamsMap
+		Daily  DailyParamsMap
+		Days   int
+	}
+
+	// HourlyForecast and DailyForecast leave a value nil when it was not
+	// requested or the provider did not report it, like CityWeatherCondition.
+	HourlyForecast struct {
+		Time                    time.Time
+		Temperature             *enums.Temperature
+		RelativeHumidityPercent *uint8
+		WindSpeed               *enums.Speed
+		WeatherCode             *enums.WeatherCode
+		CloudCoverPercent       *uint8
+		Precipitation           *enums.Length
+		Visibility              *enums.Length
+	}
+
+	DailyForecast struct {
+		Date             time.Time
+		WeatherCode      *enums.WeatherCode
+		TemperatureMax   *enums.Temperature
+		TemperatureMin   *enums.Temperature
+		PrecipitationSum *enums.Length
+		WindSpeedMax     *enums.Speed
+	}
+
+	CityWeatherForecast struct {
+		City       City
+		CapturedAt time.Time
+		Hourly     []HourlyForecast
+		Daily      []DailyForecast
+	}
+
+	CityWeatherForecasts []CityWeatherForecast
 )
+
+func (e OutboxEntries) IDs() []int64 {
+	ids := make([]int64, 0, len(e))
+	for _, entry := range e {
+		ids = append(ids, entry.ID)
+	}
+
+	return ids
+}
+
+func (e OutboxEntries) Conditions() CityWeatherConditions {
+	conditions := make(CityWeatherConditions, 0, len(e))
+	for _, entry := range e {
+		conditions = append(conditions, entry.Condition)
+	}
+
+	return conditions
+}
+
+// Fingerprint identifies a reading by its capture time and measured values.
+// The provider and the coordinates it echoes back are left out, they do not
+// make a reading any different for consumers.
+func (c CityWeatherCondition) Fingerprint() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s|%s",
+		c.CapturedAt.UTC().Format(time.RFC3339Nano),
+		fingerprintValue(c.Temperature),
+		fingerprintValue(c.RelativeHumidityPercent),
+		fingerprintValue(c.WindSpeed),
+		fingerprintValue(c.WeatherCode),
+		fingerprintValue(c.CloudCoverPercent),
+		fingerprintValue(c.Precipitation),
+		fingerprintValue(c.Visibility),
+	)))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func fingerprintValue[T any](value *T) string {
+	if value == nil {
+		return "null"
+	}
+
+	return fmt.Sprint(*value)
+}
+
+// Changed returns the conditions whose fingerprint differs from the last
+// published one of their city, in order, together with their fingerprints.
+// The receiver is updated as well, so a repeated reading later in the same
+// slice is not returned twice.
+func (f Fingerprints) Changed(conditions CityWeatherConditions) (CityWeatherConditions, Fingerprints) {
+	var (
+		changed = make(CityWeatherConditions, 0, len(conditions))
+		updated = make(Fingerprints)
+	)
+
+	for _, condition := range conditions {
+		fingerprint := condition.Fingerprint()
+		if f[condition.City.ID] == fingerprint {
+			continue
+		}
+
+		f[condition.City.ID] = fingerprint
+		updated[condition.City.ID] = fingerprint
+		changed = append(changed, condition)
+	}
+
+	return changed, updated
+}
+
+// Validate rejects params without a registered variable, those would be
+// requested from the provider but never decoded. The configured field has to
+// be the one of the variable, another field would be decoded with the type
+// and unit of the wrong variable.
+func (m MonitoringParamsMap) Validate() error {
+	for param, field := range m {
+		variable, ok := enums.LookupVariable(param)
+		if !ok {
+			return fmt.Errorf("unknown monitoring param %q", param)
+		}
+
+		if field != variable.Field {
+			return fmt.Errorf("monitoring param %q requests field %q, expected %q", param, field, variable.Field)
+		}
+	}
+
+	return nil
+}
+
+// Validate rejects daily params without a registered variable or with a field
+// other than the one of the variable, like MonitoringParamsMap.Validate.
+func (m DailyParamsMap) Validate() error {
+	for param, field := range m {
+		variable, ok := enums.LookupDailyVariable(param)
+		if !ok {
+			return fmt.Errorf("unknown forecast daily param %q", param)
+		}
+
+		if field != variable.Field {
+			return fmt.Errorf("forecast daily param %q requests field %q, expected %q", param, field, variable.Field)
+		}
+	}
+
+	return nil
+}
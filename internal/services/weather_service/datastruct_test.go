@@ -0,0 +1,50 @@
+package weather_service_test
+
+import (
+	"testing"
+
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitoringParamsMap_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		params      weather_service.MonitoringParamsMap
+		wantErrFunc assert.ErrorAssertionFunc
+	}{
+		{
+			name: "registered fields",
+			params: weather_service.MonitoringParamsMap{
+				enums.MonitoringParamTemperature:   "temperature_2m",
+				enums.MonitoringParamPrecipitation: "precipitation",
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "unknown param",
+			params: weather_service.MonitoringParamsMap{
+				enums.MonitoringParam("dewPoint"): "dew_point_2m",
+			},
+			wantErrFunc: assert.Error,
+		},
+		{
+			name: "field of another variable",
+			params: weather_service.MonitoringParamsMap{
+				enums.MonitoringParamTemperature: "apparent_temperature",
+			},
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.wantErrFunc(t, tt.params.Validate())
+		})
+	}
+}
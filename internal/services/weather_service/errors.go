@@ -0,0 +1,11 @@
+package weather_service
+
+import "errors"
+
+// Providers wrap their failures in these errors so the service can tell a
+// request it got wrong from a provider that is throttling or unavailable.
+var (
+	ErrInvalidRequest      = errors.New("provider rejected the request")
+	ErrProviderRateLimited = errors.New("provider rate limit exceeded")
+	ErrProviderUnavailable = errors.New("provider is unavailable")
+)
@@ -3,12 +3,14 @@ package weather_service_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"testing"
 	"time"
 
 	"github.com/meteogo/logger/pkg/logger"
 	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
 	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -17,6 +19,7 @@ import (
 var (
 	berlinCondition = weather_service.CityWeatherCondition{
 		City: weather_service.City{
+			ID:   1,
 			Name: "Berlin",
 			Coordinates: weather_service.Coordinates{
 				Lat:  52.52,
@@ -24,17 +27,19 @@ var (
 			},
 		},
 		CapturedAt:              must(time.ParseInLocation(time.DateTime, "2025-05-03 12:58:00", time.FixedZone("", 0))),
-		Temperature:             12.5,
-		RelativeHumidityPercent: 20,
-		WindSpeed:               3.7,
-		WeatherCode:             enums.ClearSky,
-		CloudCoverPercent:       3,
-		Precipitation:           3 * enums.Millimeter,
-		Visibility:              40 * enums.Kilometer,
+		Temperature:             ptr.To(enums.Celsius(12.5)),
+		RelativeHumidityPercent: ptr.To[uint8](20),
+		WindSpeed:               ptr.To(enums.KilometersPerHour(3.7)),
+		WeatherCode:             ptr.To(enums.ClearSky),
+		CloudCoverPercent:       ptr.To[uint8](3),
+		Precipitation:           ptr.To(3 * enums.Millimeter),
+		Visibility:              ptr.To(40 * enums.Kilometer),
+		Provider:                enums.ProviderOpenMeteo,
 	}
 
 	parisCondition = weather_service.CityWeatherCondition{
 		City: weather_service.City{
+			ID:   2,
 			Name: "Paris",
 			Coordinates: weather_service.Coordinates{
 				Lat:  48.86,
@@ -42,17 +47,19 @@ var (
 			},
 		},
 		CapturedAt:              must(time.ParseInLocation(time.DateTime, "2025-05-03 13:03:00", time.FixedZone("", 0))),
-		Temperature:             10.5,
-		RelativeHumidityPercent: 13,
-		WindSpeed:               4.2,
-		WeatherCode:             enums.PartlyCloudy,
-		CloudCoverPercent:       29,
-		Precipitation:           15 * enums.Millimeter,
-		Visibility:              31 * enums.Kilometer,
+		Temperature:             ptr.To(enums.Celsius(10.5)),
+		RelativeHumidityPercent: ptr.To[uint8](13),
+		WindSpeed:               ptr.To(enums.KilometersPerHour(4.2)),
+		WeatherCode:             ptr.To(enums.PartlyCloudy),
+		CloudCoverPercent:       ptr.To[uint8](29),
+		Precipitation:           ptr.To(15 * enums.Millimeter),
+		Visibility:              ptr.To(31 * enums.Kilometer),
+		Provider:                enums.ProviderOpenMeteo,
 	}
 
 	londonCondition = weather_service.CityWeatherCondition{
 		City: weather_service.City{
+			ID:   3,
 			Name: "London",
 			Coordinates: weather_service.Coordinates{
 				Lat:  41.90,
@@ -60,13 +67,14 @@ var (
 			},
 		},
 		CapturedAt:              must(time.ParseInLocation(time.DateTime, "2025-05-03 13:05:00", time.FixedZone("", 0))),
-		Temperature:             11.8,
-		RelativeHumidityPercent: 20,
-		WindSpeed:               6.2,
-		WeatherCode:             enums.Fog,
-		CloudCoverPercent:       75,
-		Precipitation:           40 * enums.Millimeter,
-		Visibility:              5 * enums.Kilometer,
+		Temperature:             ptr.To(enums.Celsius(11.8)),
+		RelativeHumidityPercent: ptr.To[uint8](20),
+		WindSpeed:               ptr.To(enums.KilometersPerHour(6.2)),
+		WeatherCode:             ptr.To(enums.Fog),
+		CloudCoverPercent:       ptr.To[uint8](75),
+		Precipitation:           ptr.To(40 * enums.Millimeter),
+		Visibility:              ptr.To(5 * enums.Kilometer),
+		Provider:                enums.ProviderOpenMeteo,
 	}
 )
 
@@ -77,8 +85,11 @@ func TestWeatherService_CollectData(t *testing.T) {
 	tests := []struct {
 		name           string
 		config         func(ctrl *gomock.Controller) weather_service.Config
-		meteoClient    func(ctrl *gomock.Controller) weather_service.MeteoClient
+		primary        func(ctrl *gomock.Controller) weather_service.WeatherProvider
+		secondary      func(ctrl *gomock.Controller) weather_service.WeatherProvider
 		storage        func(ctrl *gomock.Controller) weather_service.Storage
+		alerter        func(ctrl *gomock.Controller) weather_service.Alerter
+		broadcaster    func(ctrl *gomock.Controller) weather_service.Broadcaster
 		metricsManager func(ctrl *gomock.Controller) weather_service.MetricsManager
 		wantErrFunc    assert.ErrorAssertionFunc
 	}{
@@ -87,10 +98,11 @@ func TestWeatherService_CollectData(t *testing.T) {
 			config: func(ctrl *gomock.Controller) weather_service.Config {
 				return mockConfig(ctrl)
 			},
-			meteoClient: func(ctrl *gomock.Controller) weather_service.MeteoClient {
-				mock := NewMockMeteoClient(ctrl)
+			primary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockWeatherProvider(ctrl)
 				mock.EXPECT().
 					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   1,
 						Name: "Berlin",
 						Coordinates: weather_service.Coordinates{
 							Lat:  52.52,
@@ -102,6 +114,7 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				mock.EXPECT().
 					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   2,
 						Name: "Paris",
 						Coordinates: weather_service.Coordinates{
 							Lat:  48.86,
@@ -113,6 +126,7 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				mock.EXPECT().
 					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   3,
 						Name: "London",
 						Coordinates: weather_service.Coordinates{
 							Lat:  41.90,
@@ -124,6 +138,9 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				return mock
 			},
+			secondary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				return NewMockWeatherProvider(ctrl)
+			},
 			storage: func(ctrl *gomock.Controller) weather_service.Storage {
 				mock := NewMockStorage(ctrl)
 				expectedConditions := weather_service.CityWeatherConditions{
@@ -142,6 +159,23 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				return mock
 			},
+			alerter: func(ctrl *gomock.Controller) weather_service.Alerter {
+				mock := NewMockAlerter(ctrl)
+				mock.EXPECT().
+					Evaluate(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			broadcaster: func(ctrl *gomock.Controller) weather_service.Broadcaster {
+				mock := NewMockBroadcaster(ctrl)
+				mock.EXPECT().
+					Broadcast(gomock.Any(), gomock.Any()).
+					Times(1)
+
+				return mock
+			},
 			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
 				mock := NewMockMetricsManager(ctrl)
 				mock.EXPECT().
@@ -157,10 +191,11 @@ func TestWeatherService_CollectData(t *testing.T) {
 			config: func(ctrl *gomock.Controller) weather_service.Config {
 				return mockConfig(ctrl)
 			},
-			meteoClient: func(ctrl *gomock.Controller) weather_service.MeteoClient {
-				mock := NewMockMeteoClient(ctrl)
+			primary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockWeatherProvider(ctrl)
 				mock.EXPECT().
 					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   1,
 						Name: "Berlin",
 						Coordinates: weather_service.Coordinates{
 							Lat:  52.52,
@@ -172,6 +207,7 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				mock.EXPECT().
 					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   2,
 						Name: "Paris",
 						Coordinates: weather_service.Coordinates{
 							Lat:  48.86,
@@ -183,6 +219,7 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				mock.EXPECT().
 					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   3,
 						Name: "London",
 						Coordinates: weather_service.Coordinates{
 							Lat:  41.90,
@@ -194,6 +231,15 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				return mock
 			},
+			secondary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Eq(londonCondition.City), gomock.Any()).
+					Return(weather_service.CityWeatherCondition{}, errors.New("secondary client error")).
+					Times(1)
+
+				return mock
+			},
 			storage: func(ctrl *gomock.Controller) weather_service.Storage {
 				mock := NewMockStorage(ctrl)
 				expectedConditions := weather_service.CityWeatherConditions{
@@ -211,6 +257,23 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				return mock
 			},
+			alerter: func(ctrl *gomock.Controller) weather_service.Alerter {
+				mock := NewMockAlerter(ctrl)
+				mock.EXPECT().
+					Evaluate(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			broadcaster: func(ctrl *gomock.Controller) weather_service.Broadcaster {
+				mock := NewMockBroadcaster(ctrl)
+				mock.EXPECT().
+					Broadcast(gomock.Any(), gomock.Any()).
+					Times(1)
+
+				return mock
+			},
 			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
 				mock := NewMockMetricsManager(ctrl)
 				mock.EXPECT().
@@ -222,14 +285,15 @@ func TestWeatherService_CollectData(t *testing.T) {
 			wantErrFunc: assert.NoError,
 		},
 		{
-			name: "storage error",
+			name: "failover to secondary provider",
 			config: func(ctrl *gomock.Controller) weather_service.Config {
 				return mockConfig(ctrl)
 			},
-			meteoClient: func(ctrl *gomock.Controller) weather_service.MeteoClient {
-				mock := NewMockMeteoClient(ctrl)
+			primary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockWeatherProvider(ctrl)
 				mock.EXPECT().
 					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   1,
 						Name: "Berlin",
 						Coordinates: weather_service.Coordinates{
 							Lat:  52.52,
@@ -241,6 +305,7 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				mock.EXPECT().
 					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   2,
 						Name: "Paris",
 						Coordinates: weather_service.Coordinates{
 							Lat:  48.86,
@@ -252,12 +317,22 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				mock.EXPECT().
 					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   3,
 						Name: "London",
 						Coordinates: weather_service.Coordinates{
 							Lat:  41.90,
 							Long: -0.13,
 						},
 					}), gomock.Any()).
+					Return(weather_service.CityWeatherCondition{}, errors.New("client error")).
+					Times(1)
+
+				return mock
+			},
+			secondary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Eq(londonCondition.City), gomock.Any()).
 					Return(londonCondition, nil).
 					Times(1)
 
@@ -265,22 +340,42 @@ func TestWeatherService_CollectData(t *testing.T) {
 			},
 			storage: func(ctrl *gomock.Controller) weather_service.Storage {
 				mock := NewMockStorage(ctrl)
+				londonFromSecondary := londonCondition
+				londonFromSecondary.Provider = enums.ProviderMetNorway
+
 				expectedConditions := weather_service.CityWeatherConditions{
 					berlinCondition,
 					parisCondition,
-					londonCondition,
+					londonFromSecondary,
 				}
 
 				mock.EXPECT().
 					SaveConditions(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ context.Context, conditions weather_service.CityWeatherConditions) error {
 						assert.ElementsMatch(t, expectedConditions, conditions, "Saved conditions do not match expected conditions")
-						return errors.New("storage error")
+						return nil
 					}).
 					Times(1)
 
 				return mock
 			},
+			alerter: func(ctrl *gomock.Controller) weather_service.Alerter {
+				mock := NewMockAlerter(ctrl)
+				mock.EXPECT().
+					Evaluate(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			broadcaster: func(ctrl *gomock.Controller) weather_service.Broadcaster {
+				mock := NewMockBroadcaster(ctrl)
+				mock.EXPECT().
+					Broadcast(gomock.Any(), gomock.Any()).
+					Times(1)
+
+				return mock
+			},
 			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
 				mock := NewMockMetricsManager(ctrl)
 				mock.EXPECT().
@@ -289,76 +384,1103 @@ func TestWeatherService_CollectData(t *testing.T) {
 
 				return mock
 			},
-			wantErrFunc: assert.Error,
+			wantErrFunc: assert.NoError,
 		},
-	}
+		{
+			name: "batch request",
+			config: func(ctrl *gomock.Controller) weather_service.Config {
+				return mockConfigWithBatchSize(ctrl, 3)
+			},
+			primary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockBatchWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeatherBatch(gomock.Any(), gomock.Eq(weather_service.ReportedCities{
+						berlinCondition.City,
+						parisCondition.City,
+						londonCondition.City,
+					}), gomock.Any()).
+					Return(weather_service.CityWeatherConditions{
+						berlinCondition,
+						parisCondition,
+						londonCondition,
+					}, nil).
+					Times(1)
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
+				return mock
+			},
+			secondary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				return NewMockWeatherProvider(ctrl)
+			},
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SaveConditions(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, conditions weather_service.CityWeatherConditions) error {
+						assert.ElementsMatch(t, weather_service.CityWeatherConditions{berlinCondition, parisCondition, londonCondition}, conditions)
+						return nil
+					}).
+					Times(1)
 
-			ctrl := gomock.NewController(t)
-			service := weather_service.NewService(
-				tt.config(ctrl),
-				tt.meteoClient(ctrl),
-				nil,
-				tt.storage(ctrl),
-				tt.metricsManager(ctrl),
-			)
+				return mock
+			},
+			alerter: func(ctrl *gomock.Controller) weather_service.Alerter {
+				mock := NewMockAlerter(ctrl)
+				mock.EXPECT().
+					Evaluate(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
 
-			err := service.CollectData(context.Background())
-			if !tt.wantErrFunc(t, err) {
-				t.Fail()
-			}
-		})
-	}
-}
+				return mock
+			},
+			broadcaster: func(ctrl *gomock.Controller) weather_service.Broadcaster {
+				mock := NewMockBroadcaster(ctrl)
+				mock.EXPECT().
+					Broadcast(gomock.Any(), gomock.Any()).
+					Times(1)
 
-func mockConfig(ctrl *gomock.Controller) weather_service.Config {
-	mock := NewMockConfig(ctrl)
-	mock.EXPECT().
-		ReportedCities().
-		Return(weather_service.ReportedCities{
-			{
-				Name: "Berlin",
-				Coordinates: weather_service.Coordinates{
-					Lat:  52.52,
-					Long: 13.41,
-				},
+				return mock
 			},
-			{
-				Name: "Paris",
-				Coordinates: weather_service.Coordinates{
-					Lat:  48.86,
-					Long: 2.35,
-				},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
 			},
-			{
-				Name: "London",
-				Coordinates: weather_service.Coordinates{
-					Lat:  41.90,
-					Long: -0.13,
-				},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "batch request error falls back to per city requests",
+			config: func(ctrl *gomock.Controller) weather_service.Config {
+				return mockConfigWithBatchSize(ctrl, 2)
 			},
-		}).
-		AnyTimes()
+			primary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockBatchWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeatherBatch(gomock.Any(), gomock.Len(2), gomock.Any()).
+					Return(nil, errors.New("batch error")).
+					Times(1)
 
-	mock.EXPECT().
-		MonitoringParams().
-		Return(weather_service.MonitoringParamsMap{
-			enums.MonitoringParamTemperature:      "temperature_2m",
-			enums.MonitoringParamRelativeHumidity: "relative_humidity_2m",
-			enums.MonitoringParamWindSpeed:        "wind_speed_10m",
-			enums.MonitoringParamWeatherCode:      "weather_code",
-			enums.MonitoringParamCloudCover:       "cloud_cover",
-			enums.MonitoringParamPrecipitation:    "precipitation",
-			enums.MonitoringParamVisibility:       "visibility",
-		}).
-		AnyTimes()
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Eq(berlinCondition.City), gomock.Any()).
+					Return(berlinCondition, nil).
+					Times(1)
 
-	mock.EXPECT().
-		WorkerPoolSize().
-		Return(3).
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Eq(parisCondition.City), gomock.Any()).
+					Return(parisCondition, nil).
+					Times(1)
+
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Eq(londonCondition.City), gomock.Any()).
+					Return(londonCondition, nil).
+					Times(1)
+
+				return mock
+			},
+			secondary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				return NewMockWeatherProvider(ctrl)
+			},
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SaveConditions(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, conditions weather_service.CityWeatherConditions) error {
+						assert.ElementsMatch(t, weather_service.CityWeatherConditions{berlinCondition, parisCondition, londonCondition}, conditions)
+						return nil
+					}).
+					Times(1)
+
+				return mock
+			},
+			alerter: func(ctrl *gomock.Controller) weather_service.Alerter {
+				mock := NewMockAlerter(ctrl)
+				mock.EXPECT().
+					Evaluate(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			broadcaster: func(ctrl *gomock.Controller) weather_service.Broadcaster {
+				mock := NewMockBroadcaster(ctrl)
+				mock.EXPECT().
+					Broadcast(gomock.Any(), gomock.Any()).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "rate limited batch request skips primary provider",
+			config: func(ctrl *gomock.Controller) weather_service.Config {
+				return mockConfigWithBatchSize(ctrl, 3)
+			},
+			primary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockBatchWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeatherBatch(gomock.Any(), gomock.Len(3), gomock.Any()).
+					Return(nil, fmt.Errorf("status 429: %w", weather_service.ErrProviderRateLimited)).
+					Times(1)
+
+				return mock
+			},
+			secondary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, city weather_service.City, _ weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error) {
+						return weather_service.CityWeatherCondition{City: city}, nil
+					}).
+					Times(3)
+
+				return mock
+			},
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SaveConditions(gomock.Any(), gomock.Len(3)).
+					DoAndReturn(func(_ context.Context, conditions weather_service.CityWeatherConditions) error {
+						for _, condition := range conditions {
+							assert.Equal(t, enums.ProviderMetNorway, condition.Provider)
+						}
+						return nil
+					}).
+					Times(1)
+
+				return mock
+			},
+			alerter: func(ctrl *gomock.Controller) weather_service.Alerter {
+				mock := NewMockAlerter(ctrl)
+				mock.EXPECT().
+					Evaluate(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			broadcaster: func(ctrl *gomock.Controller) weather_service.Broadcaster {
+				mock := NewMockBroadcaster(ctrl)
+				mock.EXPECT().
+					Broadcast(gomock.Any(), gomock.Any()).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "alert evaluation error does not fail collection",
+			config: func(ctrl *gomock.Controller) weather_service.Config {
+				return mockConfigWithBatchSize(ctrl, 3)
+			},
+			primary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockBatchWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeatherBatch(gomock.Any(), gomock.Eq(weather_service.ReportedCities{
+						berlinCondition.City,
+						parisCondition.City,
+						londonCondition.City,
+					}), gomock.Any()).
+					Return(weather_service.CityWeatherConditions{
+						berlinCondition,
+						parisCondition,
+						londonCondition,
+					}, nil).
+					Times(1)
+
+				return mock
+			},
+			secondary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				return NewMockWeatherProvider(ctrl)
+			},
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SaveConditions(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, conditions weather_service.CityWeatherConditions) error {
+						assert.ElementsMatch(t, weather_service.CityWeatherConditions{berlinCondition, parisCondition, londonCondition}, conditions)
+						return nil
+					}).
+					Times(1)
+
+				return mock
+			},
+			alerter: func(ctrl *gomock.Controller) weather_service.Alerter {
+				mock := NewMockAlerter(ctrl)
+				mock.EXPECT().
+					Evaluate(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, conditions weather_service.CityWeatherConditions) error {
+						assert.ElementsMatch(t, weather_service.CityWeatherConditions{berlinCondition, parisCondition, londonCondition}, conditions)
+						return errors.New("kafka error")
+					}).
+					Times(1)
+
+				return mock
+			},
+			broadcaster: func(ctrl *gomock.Controller) weather_service.Broadcaster {
+				mock := NewMockBroadcaster(ctrl)
+				mock.EXPECT().
+					Broadcast(gomock.Any(), gomock.Any()).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "all providers fail",
+			config: func(ctrl *gomock.Controller) weather_service.Config {
+				return mockConfig(ctrl)
+			},
+			primary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(weather_service.CityWeatherCondition{}, errors.New("client error")).
+					Times(3)
+
+				return mock
+			},
+			secondary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(weather_service.CityWeatherCondition{}, errors.New("secondary client error")).
+					Times(3)
+
+				return mock
+			},
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				return NewMockStorage(ctrl)
+			},
+			alerter: func(ctrl *gomock.Controller) weather_service.Alerter {
+				return NewMockAlerter(ctrl)
+			},
+			broadcaster: func(ctrl *gomock.Controller) weather_service.Broadcaster {
+				return NewMockBroadcaster(ctrl)
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
+			},
+			wantErrFunc: assert.Error,
+		},
+		{
+			name: "storage error",
+			config: func(ctrl *gomock.Controller) weather_service.Config {
+				return mockConfig(ctrl)
+			},
+			primary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				mock := NewMockWeatherProvider(ctrl)
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   1,
+						Name: "Berlin",
+						Coordinates: weather_service.Coordinates{
+							Lat:  52.52,
+							Long: 13.41,
+						},
+					}), gomock.Any()).
+					Return(berlinCondition, nil).
+					Times(1)
+
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   2,
+						Name: "Paris",
+						Coordinates: weather_service.Coordinates{
+							Lat:  48.86,
+							Long: 2.35,
+						},
+					}), gomock.Any()).
+					Return(parisCondition, nil).
+					Times(1)
+
+				mock.EXPECT().
+					CurrentWeather(gomock.Any(), gomock.Eq(weather_service.City{
+						ID:   3,
+						Name: "London",
+						Coordinates: weather_service.Coordinates{
+							Lat:  41.90,
+							Long: -0.13,
+						},
+					}), gomock.Any()).
+					Return(londonCondition, nil).
+					Times(1)
+
+				return mock
+			},
+			secondary: func(ctrl *gomock.Controller) weather_service.WeatherProvider {
+				return NewMockWeatherProvider(ctrl)
+			},
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				expectedConditions := weather_service.CityWeatherConditions{
+					berlinCondition,
+					parisCondition,
+					londonCondition,
+				}
+
+				mock.EXPECT().
+					SaveConditions(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, conditions weather_service.CityWeatherConditions) error {
+						assert.ElementsMatch(t, expectedConditions, conditions, "Saved conditions do not match expected conditions")
+						return errors.New("storage error")
+					}).
+					Times(1)
+
+				return mock
+			},
+			alerter: func(ctrl *gomock.Controller) weather_service.Alerter {
+				return NewMockAlerter(ctrl)
+			},
+			broadcaster: func(ctrl *gomock.Controller) weather_service.Broadcaster {
+				return NewMockBroadcaster(ctrl)
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
+			},
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			service := weather_service.NewService(
+				tt.config(ctrl),
+				mockProviders(ctrl, tt.primary(ctrl), tt.secondary(ctrl)),
+				nil,
+				nil,
+				tt.storage(ctrl),
+				tt.alerter(ctrl),
+				tt.broadcaster(ctrl),
+				tt.metricsManager(ctrl),
+			)
+
+			err := service.CollectData(context.Background())
+			if !tt.wantErrFunc(t, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestWeatherService_SendData(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	var (
+		firstBatch = weather_service.OutboxEntries{
+			{ID: 1, Condition: berlinCondition},
+			{ID: 2, Condition: parisCondition},
+		}
+		secondBatch = weather_service.OutboxEntries{
+			{ID: 3, Condition: londonCondition, Attempts: 1},
+		}
+	)
+
+	tests := []struct {
+		name           string
+		storage        func(ctrl *gomock.Controller) weather_service.Storage
+		publisher      func(ctrl *gomock.Controller) weather_service.Publisher
+		metricsManager func(ctrl *gomock.Controller) weather_service.MetricsManager
+		wantErrFunc    assert.ErrorAssertionFunc
+	}{
+		{
+			name: "drains outbox in batches",
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().GetPublishedFingerprints(gomock.Any()).Return(weather_service.Fingerprints{}, nil),
+					mock.EXPECT().GetPendingOutbox(gomock.Any(), 2).Return(firstBatch, nil),
+					mock.EXPECT().SavePublishedFingerprints(gomock.Any(), weather_service.Fingerprints{
+						1: berlinCondition.Fingerprint(),
+						2: parisCondition.Fingerprint(),
+					}).Return(nil),
+					mock.EXPECT().MarkOutboxPublished(gomock.Any(), []int64{1, 2}).Return(nil),
+					mock.EXPECT().GetPendingOutbox(gomock.Any(), 2).Return(secondBatch, nil),
+					mock.EXPECT().SavePublishedFingerprints(gomock.Any(), weather_service.Fingerprints{
+						3: londonCondition.Fingerprint(),
+					}).Return(nil),
+					mock.EXPECT().MarkOutboxPublished(gomock.Any(), []int64{3}).Return(nil),
+				)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().PublishConditions(gomock.Any(), weather_service.CityWeatherConditions{berlinCondition, parisCondition}).Return(nil),
+					mock.EXPECT().PublishConditions(gomock.Any(), weather_service.CityWeatherConditions{londonCondition}).Return(nil),
+				)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddKafkaSendDurationMetric(gomock.Any(), gomock.Any()).
+					Return().
+					Times(2)
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "skips conditions published before",
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().GetPublishedFingerprints(gomock.Any()).Return(weather_service.Fingerprints{
+						1: berlinCondition.Fingerprint(),
+						2: "outdated",
+					}, nil),
+					mock.EXPECT().GetPendingOutbox(gomock.Any(), 2).Return(firstBatch, nil),
+					mock.EXPECT().SavePublishedFingerprints(gomock.Any(), weather_service.Fingerprints{
+						2: parisCondition.Fingerprint(),
+					}).Return(nil),
+					mock.EXPECT().MarkOutboxPublished(gomock.Any(), []int64{1, 2}).Return(nil),
+					mock.EXPECT().GetPendingOutbox(gomock.Any(), 2).Return(nil, nil),
+				)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				mock.EXPECT().
+					PublishConditions(gomock.Any(), weather_service.CityWeatherConditions{parisCondition}).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddKafkaSendDurationMetric(gomock.Any(), gomock.Any()).
+					Return().
+					Times(1)
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "unchanged batch is marked published without publishing",
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().GetPublishedFingerprints(gomock.Any()).Return(weather_service.Fingerprints{
+						3: londonCondition.Fingerprint(),
+					}, nil),
+					mock.EXPECT().GetPendingOutbox(gomock.Any(), 2).Return(secondBatch, nil),
+					mock.EXPECT().MarkOutboxPublished(gomock.Any(), []int64{3}).Return(nil),
+				)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				return NewMockPublisher(ctrl)
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				return NewMockMetricsManager(ctrl)
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "empty outbox",
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetPublishedFingerprints(gomock.Any()).
+					Return(weather_service.Fingerprints{}, nil).
+					Times(1)
+				mock.EXPECT().
+					GetPendingOutbox(gomock.Any(), 2).
+					Return(nil, nil).
+					Times(1)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				return NewMockPublisher(ctrl)
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				return NewMockMetricsManager(ctrl)
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "publisher error marks batch as failed",
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().GetPublishedFingerprints(gomock.Any()).Return(weather_service.Fingerprints{}, nil),
+					mock.EXPECT().GetPendingOutbox(gomock.Any(), 2).Return(firstBatch, nil),
+					mock.EXPECT().MarkOutboxFailed(gomock.Any(), []int64{1, 2}, "kafka error").Return(nil),
+				)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				mock.EXPECT().
+					PublishConditions(gomock.Any(), gomock.Any()).
+					Return(errors.New("kafka error")).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				return NewMockMetricsManager(ctrl)
+			},
+			wantErrFunc: assert.Error,
+		},
+		{
+			name: "entries out of attempts are parked",
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().GetPublishedFingerprints(gomock.Any()).Return(weather_service.Fingerprints{}, nil),
+					mock.EXPECT().GetPendingOutbox(gomock.Any(), 2).Return(weather_service.OutboxEntries{
+						{ID: 1, Condition: berlinCondition},
+						{ID: 2, Condition: parisCondition, Attempts: 2},
+					}, nil),
+					mock.EXPECT().MarkOutboxFailed(gomock.Any(), []int64{1}, "message too large").Return(nil),
+					mock.EXPECT().ParkOutbox(gomock.Any(), []int64{2}, "message too large").Return(nil),
+				)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				mock.EXPECT().
+					PublishConditions(gomock.Any(), gomock.Any()).
+					Return(errors.New("message too large")).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				return NewMockMetricsManager(ctrl)
+			},
+			wantErrFunc: assert.Error,
+		},
+		{
+			name: "storage error",
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetPublishedFingerprints(gomock.Any()).
+					Return(weather_service.Fingerprints{}, nil).
+					Times(1)
+				mock.EXPECT().
+					GetPendingOutbox(gomock.Any(), 2).
+					Return(nil, errors.New("storage error")).
+					Times(1)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				return NewMockPublisher(ctrl)
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				return NewMockMetricsManager(ctrl)
+			},
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			service := weather_service.NewService(
+				mockConfig(ctrl),
+				nil,
+				nil,
+				tt.publisher(ctrl),
+				tt.storage(ctrl),
+				nil,
+				nil,
+				tt.metricsManager(ctrl),
+			)
+
+			err := service.SendData(context.Background())
+			if !tt.wantErrFunc(t, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestWeatherService_PublishSnapshot(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	tests := []struct {
+		name           string
+		storage        func(ctrl *gomock.Controller) weather_service.Storage
+		publisher      func(ctrl *gomock.Controller) weather_service.Publisher
+		metricsManager func(ctrl *gomock.Controller) weather_service.MetricsManager
+		wantErrFunc    assert.ErrorAssertionFunc
+	}{
+		{
+			name: "publishes latest conditions",
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().GetConditions(gomock.Any()).Return(weather_service.CityWeatherConditions{berlinCondition, parisCondition}, nil),
+					mock.EXPECT().SavePublishedFingerprints(gomock.Any(), weather_service.Fingerprints{
+						1: berlinCondition.Fingerprint(),
+						2: parisCondition.Fingerprint(),
+					}).Return(nil),
+				)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				mock.EXPECT().
+					PublishConditions(gomock.Any(), weather_service.CityWeatherConditions{berlinCondition, parisCondition}).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddKafkaSendDurationMetric(gomock.Any(), gomock.Any()).
+					Return().
+					Times(1)
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name: "publisher error",
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetConditions(gomock.Any()).
+					Return(weather_service.CityWeatherConditions{berlinCondition}, nil).
+					Times(1)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				mock.EXPECT().
+					PublishConditions(gomock.Any(), gomock.Any()).
+					Return(errors.New("kafka error")).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				return NewMockMetricsManager(ctrl)
+			},
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			service := weather_service.NewService(
+				mockConfig(ctrl),
+				nil,
+				nil,
+				tt.publisher(ctrl),
+				tt.storage(ctrl),
+				nil,
+				nil,
+				tt.metricsManager(ctrl),
+			)
+
+			err := service.PublishSnapshot(context.Background())
+			if !tt.wantErrFunc(t, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestWeatherService_CollectForecasts(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	forecastFor := func(city weather_service.City) weather_service.CityWeatherForecast {
+		return weather_service.CityWeatherForecast{
+			City:       city,
+			CapturedAt: must(time.ParseInLocation(time.DateTime, "2025-05-03 13:00:00", time.FixedZone("", 0))),
+			Hourly: []weather_service.HourlyForecast{
+				{
+					Time:        must(time.ParseInLocation(time.DateTime, "2025-05-03 14:00:00", time.FixedZone("", 0))),
+					Temperature: ptr.To(enums.Celsius(14.2)),
+				},
+			},
+			Daily: []weather_service.DailyForecast{
+				{
+					Date:           must(time.ParseInLocation(time.DateOnly, "2025-05-03", time.FixedZone("", 0))),
+					TemperatureMax: ptr.To(enums.Celsius(17.1)),
+				},
+			},
+		}
+	}
+
+	var (
+		berlinForecast = forecastFor(berlinCondition.City)
+		parisForecast  = forecastFor(parisCondition.City)
+		londonForecast = forecastFor(weather_service.City{
+			ID:   3,
+			Name: "London",
+			Coordinates: weather_service.Coordinates{
+				Lat:  41.90,
+				Long: -0.13,
+			},
+		})
+	)
+
+	forecastClientWith := func(londonErr error) func(ctrl *gomock.Controller) weather_service.ForecastClient {
+		return func(ctrl *gomock.Controller) weather_service.ForecastClient {
+			mock := NewMockForecastClient(ctrl)
+			mock.EXPECT().
+				Forecast(gomock.Any(), gomock.Eq(berlinForecast.City), gomock.Any()).
+				Return(berlinForecast, nil).
+				Times(1)
+
+			mock.EXPECT().
+				Forecast(gomock.Any(), gomock.Eq(parisForecast.City), gomock.Any()).
+				Return(parisForecast, nil).
+				Times(1)
+
+			if londonErr != nil {
+				mock.EXPECT().
+					Forecast(gomock.Any(), gomock.Eq(londonForecast.City), gomock.Any()).
+					Return(weather_service.CityWeatherForecast{}, londonErr).
+					Times(1)
+			} else {
+				mock.EXPECT().
+					Forecast(gomock.Any(), gomock.Eq(londonForecast.City), gomock.Any()).
+					Return(londonForecast, nil).
+					Times(1)
+			}
+
+			return mock
+		}
+	}
+
+	tests := []struct {
+		name           string
+		forecastClient func(ctrl *gomock.Controller) weather_service.ForecastClient
+		storage        func(ctrl *gomock.Controller) weather_service.Storage
+		publisher      func(ctrl *gomock.Controller) weather_service.Publisher
+		metricsManager func(ctrl *gomock.Controller) weather_service.MetricsManager
+		wantErrFunc    assert.ErrorAssertionFunc
+	}{
+		{
+			name:           "happy path",
+			forecastClient: forecastClientWith(nil),
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SaveForecasts(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, forecasts weather_service.CityWeatherForecasts) error {
+						assert.ElementsMatch(t, weather_service.CityWeatherForecasts{berlinForecast, parisForecast, londonForecast}, forecasts)
+						return nil
+					}).
+					Times(1)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				mock.EXPECT().
+					PublishForecasts(gomock.Any(), gomock.Len(3)).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				mock.EXPECT().
+					AddKafkaSendDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:           "meteo client error",
+			forecastClient: forecastClientWith(errors.New("client error")),
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SaveForecasts(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, forecasts weather_service.CityWeatherForecasts) error {
+						assert.ElementsMatch(t, weather_service.CityWeatherForecasts{berlinForecast, parisForecast}, forecasts)
+						return nil
+					}).
+					Times(1)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				mock.EXPECT().
+					PublishForecasts(gomock.Any(), gomock.Len(2)).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				mock.EXPECT().
+					AddKafkaSendDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
+			},
+			wantErrFunc: assert.NoError,
+		},
+		{
+			name:           "storage error",
+			forecastClient: forecastClientWith(nil),
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SaveForecasts(gomock.Any(), gomock.Any()).
+					Return(errors.New("storage error")).
+					Times(1)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				return NewMockPublisher(ctrl)
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
+			},
+			wantErrFunc: assert.Error,
+		},
+		{
+			name:           "publisher error",
+			forecastClient: forecastClientWith(nil),
+			storage: func(ctrl *gomock.Controller) weather_service.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					SaveForecasts(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			publisher: func(ctrl *gomock.Controller) weather_service.Publisher {
+				mock := NewMockPublisher(ctrl)
+				mock.EXPECT().
+					PublishForecasts(gomock.Any(), gomock.Any()).
+					Return(errors.New("publisher error")).
+					Times(1)
+
+				return mock
+			},
+			metricsManager: func(ctrl *gomock.Controller) weather_service.MetricsManager {
+				mock := NewMockMetricsManager(ctrl)
+				mock.EXPECT().
+					AddMeteoClientDurationMetric(gomock.Any(), gomock.Any()).
+					Return()
+
+				return mock
+			},
+			wantErrFunc: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			service := weather_service.NewService(
+				mockConfig(ctrl),
+				nil,
+				tt.forecastClient(ctrl),
+				tt.publisher(ctrl),
+				tt.storage(ctrl),
+				nil,
+				nil,
+				tt.metricsManager(ctrl),
+			)
+
+			err := service.CollectForecasts(context.Background())
+			if !tt.wantErrFunc(t, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func mockProviders(ctrl *gomock.Controller, primary, secondary weather_service.WeatherProvider) weather_service.ProviderRegistry {
+	mock := NewMockProviderRegistry(ctrl)
+	mock.EXPECT().
+		Provider(enums.ProviderOpenMeteo).
+		Return(primary, true).
+		AnyTimes()
+
+	mock.EXPECT().
+		Provider(enums.ProviderMetNorway).
+		Return(secondary, true).
+		AnyTimes()
+	return mock
+}
+
+func mockConfig(ctrl *gomock.Controller) weather_service.Config {
+	return mockConfigWithBatchSize(ctrl, 1)
+}
+
+func mockConfigWithBatchSize(ctrl *gomock.Controller, batchSize int) weather_service.Config {
+	mock := NewMockConfig(ctrl)
+	mock.EXPECT().
+		ReportedCities().
+		Return(weather_service.ReportedCities{
+			{
+				ID:   1,
+				Name: "Berlin",
+				Coordinates: weather_service.Coordinates{
+					Lat:  52.52,
+					Long: 13.41,
+				},
+			},
+			{
+				ID:   2,
+				Name: "Paris",
+				Coordinates: weather_service.Coordinates{
+					Lat:  48.86,
+					Long: 2.35,
+				},
+			},
+			{
+				ID:   3,
+				Name: "London",
+				Coordinates: weather_service.Coordinates{
+					Lat:  41.90,
+					Long: -0.13,
+				},
+			},
+		}).
+		AnyTimes()
+
+	mock.EXPECT().
+		MonitoringParams().
+		Return(weather_service.MonitoringParamsMap{
+			enums.MonitoringParamTemperature:      "temperature_2m",
+			enums.MonitoringParamRelativeHumidity: "relative_humidity_2m",
+			enums.MonitoringParamWindSpeed:        "wind_speed_10m",
+			enums.MonitoringParamWeatherCode:      "weather_code",
+			enums.MonitoringParamCloudCover:       "cloud_cover",
+			enums.MonitoringParamPrecipitation:    "precipitation",
+			enums.MonitoringParamVisibility:       "visibility",
+		}).
+		AnyTimes()
+
+	mock.EXPECT().
+		WorkerPoolSize().
+		Return(3).
+		AnyTimes()
+
+	mock.EXPECT().
+		ForecastParams().
+		Return(weather_service.ForecastParams{
+			Hourly: weather_service.MonitoringParamsMap{
+				enums.MonitoringParamTemperature: "temperature_2m",
+			},
+			Daily: weather_service.DailyParamsMap{
+				enums.DailyParamTemperatureMax: "temperature_2m_max",
+			},
+			Days: 3,
+		}).
+		AnyTimes()
+
+	mock.EXPECT().
+		OutboxBatchSize().
+		Return(2).
+		AnyTimes()
+
+	mock.EXPECT().
+		OutboxMaxAttempts().
+		Return(3).
+		AnyTimes()
+
+	mock.EXPECT().
+		Providers().
+		Return([]enums.Provider{enums.ProviderOpenMeteo, enums.ProviderMetNorway}).
+		AnyTimes()
+
+	mock.EXPECT().
+		BatchSize().
+		Return(batchSize).
 		AnyTimes()
 	return mock
 }
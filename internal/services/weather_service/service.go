@@ -2,12 +2,14 @@ package weather_service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
 	"time"
 
 	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
 	"go.opentelemetry.io/otel"
 )
 
@@ -17,19 +19,57 @@ type Config interface {
 	ReportedCities() ReportedCities
 	MonitoringParams() MonitoringParamsMap
 	WorkerPoolSize() int
+	ForecastParams() ForecastParams
+	OutboxBatchSize() int
+	OutboxMaxAttempts() int
+	Providers() []enums.Provider
+	BatchSize() int
 }
 
-type MeteoClient interface {
+type WeatherProvider interface {
 	CurrentWeather(ctx context.Context, city City, params MonitoringParamsMap) (CityWeatherCondition, error)
 }
 
+// BatchWeatherProvider is implemented by providers able to serve several
+// cities with a single request.
+type BatchWeatherProvider interface {
+	WeatherProvider
+	CurrentWeatherBatch(ctx context.Context, cities ReportedCities, params MonitoringParamsMap) (CityWeatherConditions, error)
+}
+
+type ProviderRegistry interface {
+	Provider(name enums.Provider) (WeatherProvider, bool)
+}
+
+type ForecastClient interface {
+	Forecast(ctx context.Context, city City, params ForecastParams) (CityWeatherForecast, error)
+}
+
 type Publisher interface {
 	PublishConditions(ctx context.Context, conditions CityWeatherConditions) error
+	PublishForecasts(ctx context.Context, forecasts CityWeatherForecasts) error
 }
 
 type Storage interface {
 	SaveConditions(ctx context.Context, conditions CityWeatherConditions) error
+	GetPendingOutbox(ctx context.Context, limit int) (OutboxEntries, error)
+	MarkOutboxPublished(ctx context.Context, ids []int64) error
+	MarkOutboxFailed(ctx context.Context, ids []int64, reason string) error
+	ParkOutbox(ctx context.Context, ids []int64, reason string) error
+	SaveForecasts(ctx context.Context, forecasts CityWeatherForecasts) error
 	GetConditions(ctx context.Context) (CityWeatherConditions, error)
+	GetPublishedFingerprints(ctx context.Context) (Fingerprints, error)
+	SavePublishedFingerprints(ctx context.Context, fingerprints Fingerprints) error
+}
+
+// Alerter evaluates alert rules against the collected readings.
+type Alerter interface {
+	Evaluate(ctx context.Context, conditions CityWeatherConditions) error
+}
+
+// Broadcaster pushes the collected readings to live subscribers.
+type Broadcaster interface {
+	Broadcast(ctx context.Context, conditions CityWeatherConditions)
 }
 
 type MetricsManager interface {
@@ -39,24 +79,33 @@ type MetricsManager interface {
 
 type Service struct {
 	config         Config
-	meteoClient    MeteoClient
+	providers      ProviderRegistry
+	forecastClient ForecastClient
 	publisher      Publisher
 	storage        Storage
+	alerter        Alerter
+	broadcaster    Broadcaster
 	metricsManager MetricsManager
 }
 
 func NewService(
 	config Config,
-	meteoClient MeteoClient,
+	providers ProviderRegistry,
+	forecastClient ForecastClient,
 	publisher Publisher,
 	storage Storage,
+	alerter Alerter,
+	broadcaster Broadcaster,
 	metricsManager MetricsManager,
 ) *Service {
 	return &Service{
 		config:         config,
-		meteoClient:    meteoClient,
+		providers:      providers,
+		forecastClient: forecastClient,
 		publisher:      publisher,
 		storage:        storage,
+		alerter:        alerter,
+		broadcaster:    broadcaster,
 		metricsManager: metricsManager,
 	}
 }
@@ -65,43 +114,173 @@ func (s *Service) CollectData(ctx context.Context) error {
 	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.CollectData]", s))
 	defer span.End()
 
+	cities := s.config.ReportedCities()
+
 	collectStart := time.Now()
-	conditions := s.collectDataFromClient(spanCtx)
+	conditions := s.collectDataFromClient(spanCtx, cities)
 	s.metricsManager.AddMeteoClientDurationMetric(ctx, time.Since(collectStart))
 
+	// Failed cities are only logged, but when none succeeded every provider
+	// is down and the run must not count as a successful collection.
+	if len(cities) > 0 && len(conditions) == 0 {
+		return fmt.Errorf("no weather conditions collected for %d cities", len(cities))
+	}
+
 	if err := s.storage.SaveConditions(spanCtx, conditions); err != nil {
 		return err
 	}
 
 	logger.Info(ctx, "successfully saved reported cities", slog.Int("savedCitiesCount", len(conditions)))
+	s.broadcaster.Broadcast(spanCtx, conditions)
+
+	// The readings are saved at this point, a failed alert evaluation must
+	// not fail the collection.
+	if err := s.alerter.Evaluate(spanCtx, conditions); err != nil {
+		logger.Error(ctx, "unable to evaluate weather alerts", slog.Any("error", err))
+	}
+
 	return nil
 }
 
-func (s *Service) collectDataFromClient(ctx context.Context) CityWeatherConditions {
-	_, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.collectDataFromClient]", s))
+func (s *Service) collectDataFromClient(ctx context.Context, cities ReportedCities) CityWeatherConditions {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.collectDataFromClient]", s))
 	defer span.End()
 
-	reportedCities := s.config.ReportedCities()
-	if len(reportedCities) == 0 {
-		return CityWeatherConditions{}
+	var (
+		params    = s.config.MonitoringParams()
+		providers = s.config.Providers()
+	)
+
+	batches := splitIntoBatches(cities, s.config.BatchSize())
+	return collectConcurrently(spanCtx, batches, s.config.WorkerPoolSize(), func(ctx context.Context, batch ReportedCities) ([]CityWeatherCondition, error) {
+		return s.currentWeatherBatch(ctx, batch, params, providers), nil
+	})
+}
+
+// currentWeatherBatch asks the primary provider for the whole batch when it
+// supports multi-location requests. If it does not, or the batch request
+// fails, every city of the batch goes through the per-city failover chain.
+// A throttled or unavailable primary is left out of that chain, asking it
+// again city by city would only make things worse.
+func (s *Service) currentWeatherBatch(ctx context.Context, batch ReportedCities, params MonitoringParamsMap, providers []enums.Provider) CityWeatherConditions {
+	if len(batch) > 1 && len(providers) > 0 {
+		if provider, ok := s.providers.Provider(providers[0]); ok {
+			if batchProvider, ok := provider.(BatchWeatherProvider); ok {
+				conditions, err := batchProvider.CurrentWeatherBatch(ctx, batch, params)
+				if err == nil {
+					for i := range conditions {
+						conditions[i].Provider = providers[0]
+					}
+
+					return conditions
+				}
+
+				logger.Warn(ctx, "batch request failed, falling back to per city requests", slog.String("provider", string(providers[0])), slog.Int("batchSize", len(batch)), slog.Any("error", err))
+				if errors.Is(err, ErrProviderRateLimited) || errors.Is(err, ErrProviderUnavailable) {
+					providers = providers[1:]
+				}
+			}
+		}
+	}
+
+	conditions := make(CityWeatherConditions, 0, len(batch))
+	for _, city := range batch {
+		condition, err := s.currentWeather(ctx, city, params, providers)
+		if err != nil {
+			logger.Error(ctx, "unable to get current weather for city", slog.Any("city", city), slog.Any("err", err))
+			continue
+		}
+
+		conditions = append(conditions, condition)
 	}
 
-	cityChan := make(chan City, len(reportedCities))
-	resultChan := make(chan CityWeatherCondition, len(reportedCities))
+	return conditions
+}
+
+// currentWeather asks the providers in configured order and returns the first
+// successful reading, so an outage of the primary provider only costs a
+// failover for the affected city.
+func (s *Service) currentWeather(ctx context.Context, city City, params MonitoringParamsMap, providers []enums.Provider) (CityWeatherCondition, error) {
+	var errs []error
+	for _, name := range providers {
+		provider, ok := s.providers.Provider(name)
+		if !ok {
+			errs = append(errs, fmt.Errorf("provider %s is not registered", name))
+			continue
+		}
+
+		condition, err := provider.CurrentWeather(ctx, city, params)
+		if err != nil {
+			logger.Warn(ctx, "weather provider failed, trying next one", slog.String("provider", string(name)), slog.String("city", city.Name), slog.Any("error", err))
+			errs = append(errs, fmt.Errorf("provider %s: %w", name, err))
+			continue
+		}
+
+		condition.Provider = name
+		return condition, nil
+	}
+
+	return CityWeatherCondition{}, errors.Join(errs...)
+}
+
+func (s *Service) CollectForecasts(ctx context.Context) error {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.CollectForecasts]", s))
+	defer span.End()
+
+	params := s.config.ForecastParams()
+
+	collectStart := time.Now()
+	forecasts := CityWeatherForecasts(collectConcurrently(spanCtx, s.config.ReportedCities(), s.config.WorkerPoolSize(), func(ctx context.Context, city City) ([]CityWeatherForecast, error) {
+		forecast, err := s.forecastClient.Forecast(ctx, city, params)
+		if err != nil {
+			return nil, err
+		}
+
+		return []CityWeatherForecast{forecast}, nil
+	}))
+	s.metricsManager.AddMeteoClientDurationMetric(ctx, time.Since(collectStart))
+
+	if err := s.storage.SaveForecasts(spanCtx, forecasts); err != nil {
+		return err
+	}
+
+	publishStart := time.Now()
+	if err := s.publisher.PublishForecasts(spanCtx, forecasts); err != nil {
+		logger.Error(ctx, "error publishing forecasts", slog.Any("error", err))
+		return err
+	}
+
+	s.metricsManager.AddKafkaSendDurationMetric(ctx, time.Since(publishStart))
+	logger.Info(ctx, "weather forecasts saved and published successfully", slog.Int("forecastCitiesCount", len(forecasts)))
+	return nil
+}
+
+func collectConcurrently[I, T any](
+	ctx context.Context,
+	items []I,
+	workerPoolSize int,
+	fetch func(ctx context.Context, item I) ([]T, error),
+) []T {
+	if len(items) == 0 {
+		return []T{}
+	}
+
+	itemChan := make(chan I, len(items))
+	resultChan := make(chan []T, len(items))
 
 	var (
-		wg         sync.WaitGroup
-		resultWg   sync.WaitGroup
-		mu         sync.Mutex
-		conditions CityWeatherConditions
+		wg       sync.WaitGroup
+		resultWg sync.WaitGroup
+		mu       sync.Mutex
+		results  []T
 	)
 
-	for _, city := range reportedCities {
-		cityChan <- city
+	for _, item := range items {
+		itemChan <- item
 	}
-	close(cityChan)
+	close(itemChan)
 
-	for i := 0; i < s.config.WorkerPoolSize() && i < len(reportedCities); i++ {
+	for i := 0; i < workerPoolSize && i < len(items); i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -109,18 +288,18 @@ func (s *Service) collectDataFromClient(ctx context.Context) CityWeatherConditio
 				select {
 				case <-ctx.Done():
 					return
-				case city, ok := <-cityChan:
+				case item, ok := <-itemChan:
 					if !ok {
 						return
 					}
 
-					weather, err := s.meteoClient.CurrentWeather(ctx, city, s.config.MonitoringParams())
+					result, err := fetch(ctx, item)
 					if err != nil {
-						logger.Error(ctx, "unable to get current weather for city", slog.Any("city", city), slog.Any("err", err))
+						logger.Error(ctx, "unable to get weather", slog.Any("item", item), slog.Any("err", err))
 						continue
 					}
 
-					resultChan <- weather
+					resultChan <- result
 				}
 			}
 		}()
@@ -131,7 +310,7 @@ func (s *Service) collectDataFromClient(ctx context.Context) CityWeatherConditio
 		defer resultWg.Done()
 		for result := range resultChan {
 			mu.Lock()
-			conditions = append(conditions, result)
+			results = append(results, result...)
 			mu.Unlock()
 		}
 	}()
@@ -140,26 +319,166 @@ func (s *Service) collectDataFromClient(ctx context.Context) CityWeatherConditio
 	close(resultChan)
 	resultWg.Wait()
 
-	return conditions
+	return results
+}
+
+func splitIntoBatches(cities ReportedCities, size int) []ReportedCities {
+	if size < 1 {
+		size = 1
+	}
+
+	batches := make([]ReportedCities, 0, (len(cities)+size-1)/size)
+	for start := 0; start < len(cities); start += size {
+		end := min(start+size, len(cities))
+		batches = append(batches, cities[start:end])
+	}
+
+	return batches
 }
 
+// SendData drains the outbox filled by CollectData. Entries are published in
+// insertion order and marked as published only after the publisher returns, so
+// a failed batch stays pending and is retried on the next tick. Entries that
+// failed OutboxMaxAttempts times are parked, a batch the broker keeps
+// rejecting does not hold back the entries queued after it.
+//
+// Only conditions that changed since the last publish of their city are sent,
+// the rest is marked as published right away. The fingerprints of published
+// conditions are persisted, so a restart does not send everything again.
 func (s *Service) SendData(ctx context.Context) error {
 	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.SendData]", s))
 	defer span.End()
 
+	fingerprints, err := s.storage.GetPublishedFingerprints(spanCtx)
+	if err != nil {
+		logger.Error(ctx, "error getting published fingerprints from storage", slog.Any("error", err))
+		return err
+	}
+
+	if fingerprints == nil {
+		fingerprints = make(Fingerprints)
+	}
+
+	var (
+		batchSize      = s.config.OutboxBatchSize()
+		publishedCount = 0
+		unchangedCount = 0
+	)
+
+	for {
+		entries, err := s.storage.GetPendingOutbox(spanCtx, batchSize)
+		if err != nil {
+			logger.Error(ctx, "error getting pending outbox entries from storage", slog.Any("error", err))
+			return err
+		}
+
+		if len(entries) == 0 {
+			break
+		}
+
+		changed, updated := fingerprints.Changed(entries.Conditions())
+		if len(changed) > 0 {
+			if err := s.publishConditions(spanCtx, changed, updated); err != nil {
+				s.failOutbox(spanCtx, entries, err)
+				return err
+			}
+		}
+
+		if err := s.storage.MarkOutboxPublished(spanCtx, entries.IDs()); err != nil {
+			logger.Error(ctx, "error marking outbox entries as published", slog.Any("error", err))
+			return err
+		}
+
+		publishedCount += len(changed)
+		unchangedCount += len(entries) - len(changed)
+		if len(entries) < batchSize {
+			break
+		}
+	}
+
+	if publishedCount == 0 && unchangedCount == 0 {
+		logger.Debug(ctx, "outbox is empty, nothing to publish")
+		return nil
+	}
+
+	logger.Info(ctx, "weather conditions published successfully", slog.Int("publishedCount", publishedCount), slog.Int("unchangedCount", unchangedCount))
+	return nil
+}
+
+// failOutbox records a failed publish of entries and parks those that used
+// up their attempts.
+func (s *Service) failOutbox(ctx context.Context, entries OutboxEntries, reason error) {
+	var (
+		maxAttempts = s.config.OutboxMaxAttempts()
+		retried     = make([]int64, 0, len(entries))
+		parked      = make([]int64, 0)
+	)
+
+	for _, entry := range entries {
+		if entry.Attempts+1 >= maxAttempts {
+			parked = append(parked, entry.ID)
+			continue
+		}
+
+		retried = append(retried, entry.ID)
+	}
+
+	if err := s.storage.MarkOutboxFailed(ctx, retried, reason.Error()); err != nil {
+		logger.Error(ctx, "error marking outbox entries as failed", slog.Any("error", err))
+	}
+
+	if len(parked) == 0 {
+		return
+	}
+
+	if err := s.storage.ParkOutbox(ctx, parked, reason.Error()); err != nil {
+		logger.Error(ctx, "error parking outbox entries", slog.Any("error", err))
+		return
+	}
+
+	logger.Warn(ctx, "outbox entries parked after their last attempt", slog.Int("parkedCount", len(parked)), slog.Int("maxAttempts", maxAttempts), slog.Any("error", reason))
+}
+
+// PublishSnapshot publishes the latest condition of every city whether it
+// changed or not, for consumers that rebuild their state from the topic.
+func (s *Service) PublishSnapshot(ctx context.Context) error {
+	spanCtx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.PublishSnapshot]", s))
+	defer span.End()
+
 	conditions, err := s.storage.GetConditions(spanCtx)
 	if err != nil {
-		logger.Error(ctx, "error getting conditions from storage", slog.Any("error", err))
+		logger.Error(ctx, "error getting latest conditions from storage", slog.Any("error", err))
 		return err
 	}
 
+	if len(conditions) == 0 {
+		logger.Debug(ctx, "no conditions stored yet, nothing to snapshot")
+		return nil
+	}
+
+	_, fingerprints := make(Fingerprints).Changed(conditions)
+	if err := s.publishConditions(spanCtx, conditions, fingerprints); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "weather conditions snapshot published successfully", slog.Int("publishedCount", len(conditions)))
+	return nil
+}
+
+// publishConditions publishes conditions and remembers their fingerprints as
+// the last published ones.
+func (s *Service) publishConditions(ctx context.Context, conditions CityWeatherConditions, fingerprints Fingerprints) error {
 	publishStart := time.Now()
-	if err := s.publisher.PublishConditions(spanCtx, conditions); err != nil {
+	if err := s.publisher.PublishConditions(ctx, conditions); err != nil {
 		logger.Error(ctx, "error publishing conditions", slog.Any("error", err))
 		return err
 	}
 
 	s.metricsManager.AddKafkaSendDurationMetric(ctx, time.Since(publishStart))
-	logger.Info(ctx, "weather conditions published successfully")
+	if err := s.storage.SavePublishedFingerprints(ctx, fingerprints); err != nil {
+		logger.Error(ctx, "error saving published fingerprints", slog.Any("error", err))
+		return err
+	}
+
 	return nil
 }
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	enums "github.com/meteogo/weather-collector-service/internal/pkg/enums"
 	weather_service "github.com/meteogo/weather-collector-service/internal/services/weather_service"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,6 +43,82 @@ func (m *MockConfig) EXPECT() *MockConfigMockRecorder {
 	return m.recorder
 }
 
+// BatchSize mocks base method.
+func (m *MockConfig) BatchSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// BatchSize indicates an expected call of BatchSize.
+func (mr *MockConfigMockRecorder) BatchSize() *MockConfigBatchSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSize", reflect.TypeOf((*MockConfig)(nil).BatchSize))
+	return &MockConfigBatchSizeCall{Call: call}
+}
+
+// MockConfigBatchSizeCall wrap *gomock.Call
+type MockConfigBatchSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigBatchSizeCall) Return(arg0 int) *MockConfigBatchSizeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigBatchSizeCall) Do(f func() int) *MockConfigBatchSizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigBatchSizeCall) DoAndReturn(f func() int) *MockConfigBatchSizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ForecastParams mocks base method.
+func (m *MockConfig) ForecastParams() weather_service.ForecastParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForecastParams")
+	ret0, _ := ret[0].(weather_service.ForecastParams)
+	return ret0
+}
+
+// ForecastParams indicates an expected call of ForecastParams.
+func (mr *MockConfigMockRecorder) ForecastParams() *MockConfigForecastParamsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForecastParams", reflect.TypeOf((*MockConfig)(nil).ForecastParams))
+	return &MockConfigForecastParamsCall{Call: call}
+}
+
+// MockConfigForecastParamsCall wrap *gomock.Call
+type MockConfigForecastParamsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigForecastParamsCall) Return(arg0 weather_service.ForecastParams) *MockConfigForecastParamsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigForecastParamsCall) Do(f func() weather_service.ForecastParams) *MockConfigForecastParamsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigForecastParamsCall) DoAndReturn(f func() weather_service.ForecastParams) *MockConfigForecastParamsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MonitoringParams mocks base method.
 func (m *MockConfig) MonitoringParams() weather_service.MonitoringParamsMap {
 	m.ctrl.T.Helper()
@@ -80,6 +157,120 @@ func (c *MockConfigMonitoringParamsCall) DoAndReturn(f func() weather_service.Mo
 	return c
 }
 
+// OutboxBatchSize mocks base method.
+func (m *MockConfig) OutboxBatchSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxBatchSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// OutboxBatchSize indicates an expected call of OutboxBatchSize.
+func (mr *MockConfigMockRecorder) OutboxBatchSize() *MockConfigOutboxBatchSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxBatchSize", reflect.TypeOf((*MockConfig)(nil).OutboxBatchSize))
+	return &MockConfigOutboxBatchSizeCall{Call: call}
+}
+
+// MockConfigOutboxBatchSizeCall wrap *gomock.Call
+type MockConfigOutboxBatchSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigOutboxBatchSizeCall) Return(arg0 int) *MockConfigOutboxBatchSizeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigOutboxBatchSizeCall) Do(f func() int) *MockConfigOutboxBatchSizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigOutboxBatchSizeCall) DoAndReturn(f func() int) *MockConfigOutboxBatchSizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OutboxMaxAttempts mocks base method.
+func (m *MockConfig) OutboxMaxAttempts() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxMaxAttempts")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// OutboxMaxAttempts indicates an expected call of OutboxMaxAttempts.
+func (mr *MockConfigMockRecorder) OutboxMaxAttempts() *MockConfigOutboxMaxAttemptsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxMaxAttempts", reflect.TypeOf((*MockConfig)(nil).OutboxMaxAttempts))
+	return &MockConfigOutboxMaxAttemptsCall{Call: call}
+}
+
+// MockConfigOutboxMaxAttemptsCall wrap *gomock.Call
+type MockConfigOutboxMaxAttemptsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigOutboxMaxAttemptsCall) Return(arg0 int) *MockConfigOutboxMaxAttemptsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigOutboxMaxAttemptsCall) Do(f func() int) *MockConfigOutboxMaxAttemptsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigOutboxMaxAttemptsCall) DoAndReturn(f func() int) *MockConfigOutboxMaxAttemptsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Providers mocks base method.
+func (m *MockConfig) Providers() []enums.Provider {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Providers")
+	ret0, _ := ret[0].([]enums.Provider)
+	return ret0
+}
+
+// Providers indicates an expected call of Providers.
+func (mr *MockConfigMockRecorder) Providers() *MockConfigProvidersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Providers", reflect.TypeOf((*MockConfig)(nil).Providers))
+	return &MockConfigProvidersCall{Call: call}
+}
+
+// MockConfigProvidersCall wrap *gomock.Call
+type MockConfigProvidersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigProvidersCall) Return(arg0 []enums.Provider) *MockConfigProvidersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigProvidersCall) Do(f func() []enums.Provider) *MockConfigProvidersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigProvidersCall) DoAndReturn(f func() []enums.Provider) *MockConfigProvidersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReportedCities mocks base method.
 func (m *MockConfig) ReportedCities() weather_service.ReportedCities {
 	m.ctrl.T.Helper()
@@ -156,32 +347,32 @@ func (c *MockConfigWorkerPoolSizeCall) DoAndReturn(f func() int) *MockConfigWork
 	return c
 }
 
-// MockMeteoClient is a mock of MeteoClient interface.
-type MockMeteoClient struct {
+// MockWeatherProvider is a mock of WeatherProvider interface.
+type MockWeatherProvider struct {
 	ctrl     *gomock.Controller
-	recorder *MockMeteoClientMockRecorder
+	recorder *MockWeatherProviderMockRecorder
 	isgomock struct{}
 }
 
-// MockMeteoClientMockRecorder is the mock recorder for MockMeteoClient.
-type MockMeteoClientMockRecorder struct {
-	mock *MockMeteoClient
+// MockWeatherProviderMockRecorder is the mock recorder for MockWeatherProvider.
+type MockWeatherProviderMockRecorder struct {
+	mock *MockWeatherProvider
 }
 
-// NewMockMeteoClient creates a new mock instance.
-func NewMockMeteoClient(ctrl *gomock.Controller) *MockMeteoClient {
-	mock := &MockMeteoClient{ctrl: ctrl}
-	mock.recorder = &MockMeteoClientMockRecorder{mock}
+// NewMockWeatherProvider creates a new mock instance.
+func NewMockWeatherProvider(ctrl *gomock.Controller) *MockWeatherProvider {
+	mock := &MockWeatherProvider{ctrl: ctrl}
+	mock.recorder = &MockWeatherProviderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockMeteoClient) EXPECT() *MockMeteoClientMockRecorder {
+func (m *MockWeatherProvider) EXPECT() *MockWeatherProviderMockRecorder {
 	return m.recorder
 }
 
 // CurrentWeather mocks base method.
-func (m *MockMeteoClient) CurrentWeather(ctx context.Context, city weather_service.City, params weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error) {
+func (m *MockWeatherProvider) CurrentWeather(ctx context.Context, city weather_service.City, params weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CurrentWeather", ctx, city, params)
 	ret0, _ := ret[0].(weather_service.CityWeatherCondition)
@@ -190,194 +381,850 @@ func (m *MockMeteoClient) CurrentWeather(ctx context.Context, city weather_servi
 }
 
 // CurrentWeather indicates an expected call of CurrentWeather.
-func (mr *MockMeteoClientMockRecorder) CurrentWeather(ctx, city, params any) *MockMeteoClientCurrentWeatherCall {
+func (mr *MockWeatherProviderMockRecorder) CurrentWeather(ctx, city, params any) *MockWeatherProviderCurrentWeatherCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentWeather", reflect.TypeOf((*MockMeteoClient)(nil).CurrentWeather), ctx, city, params)
-	return &MockMeteoClientCurrentWeatherCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentWeather", reflect.TypeOf((*MockWeatherProvider)(nil).CurrentWeather), ctx, city, params)
+	return &MockWeatherProviderCurrentWeatherCall{Call: call}
 }
 
-// MockMeteoClientCurrentWeatherCall wrap *gomock.Call
-type MockMeteoClientCurrentWeatherCall struct {
+// MockWeatherProviderCurrentWeatherCall wrap *gomock.Call
+type MockWeatherProviderCurrentWeatherCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockMeteoClientCurrentWeatherCall) Return(arg0 weather_service.CityWeatherCondition, arg1 error) *MockMeteoClientCurrentWeatherCall {
+func (c *MockWeatherProviderCurrentWeatherCall) Return(arg0 weather_service.CityWeatherCondition, arg1 error) *MockWeatherProviderCurrentWeatherCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockMeteoClientCurrentWeatherCall) Do(f func(context.Context, weather_service.City, weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error)) *MockMeteoClientCurrentWeatherCall {
+func (c *MockWeatherProviderCurrentWeatherCall) Do(f func(context.Context, weather_service.City, weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error)) *MockWeatherProviderCurrentWeatherCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMeteoClientCurrentWeatherCall) DoAndReturn(f func(context.Context, weather_service.City, weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error)) *MockMeteoClientCurrentWeatherCall {
+func (c *MockWeatherProviderCurrentWeatherCall) DoAndReturn(f func(context.Context, weather_service.City, weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error)) *MockWeatherProviderCurrentWeatherCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockPublisher is a mock of Publisher interface.
-type MockPublisher struct {
+// MockBatchWeatherProvider is a mock of BatchWeatherProvider interface.
+type MockBatchWeatherProvider struct {
 	ctrl     *gomock.Controller
-	recorder *MockPublisherMockRecorder
+	recorder *MockBatchWeatherProviderMockRecorder
 	isgomock struct{}
 }
 
-// MockPublisherMockRecorder is the mock recorder for MockPublisher.
-type MockPublisherMockRecorder struct {
-	mock *MockPublisher
+// MockBatchWeatherProviderMockRecorder is the mock recorder for MockBatchWeatherProvider.
+type MockBatchWeatherProviderMockRecorder struct {
+	mock *MockBatchWeatherProvider
 }
 
-// NewMockPublisher creates a new mock instance.
-func NewMockPublisher(ctrl *gomock.Controller) *MockPublisher {
-	mock := &MockPublisher{ctrl: ctrl}
-	mock.recorder = &MockPublisherMockRecorder{mock}
+// NewMockBatchWeatherProvider creates a new mock instance.
+func NewMockBatchWeatherProvider(ctrl *gomock.Controller) *MockBatchWeatherProvider {
+	mock := &MockBatchWeatherProvider{ctrl: ctrl}
+	mock.recorder = &MockBatchWeatherProviderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockPublisher) EXPECT() *MockPublisherMockRecorder {
+func (m *MockBatchWeatherProvider) EXPECT() *MockBatchWeatherProviderMockRecorder {
 	return m.recorder
 }
 
-// PublishConditions mocks base method.
-func (m *MockPublisher) PublishConditions(ctx context.Context, conditions weather_service.CityWeatherConditions) error {
+// CurrentWeather mocks base method.
+func (m *MockBatchWeatherProvider) CurrentWeather(ctx context.Context, city weather_service.City, params weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishConditions", ctx, conditions)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CurrentWeather", ctx, city, params)
+	ret0, _ := ret[0].(weather_service.CityWeatherCondition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// PublishConditions indicates an expected call of PublishConditions.
-func (mr *MockPublisherMockRecorder) PublishConditions(ctx, conditions any) *MockPublisherPublishConditionsCall {
+// CurrentWeather indicates an expected call of CurrentWeather.
+func (mr *MockBatchWeatherProviderMockRecorder) CurrentWeather(ctx, city, params any) *MockBatchWeatherProviderCurrentWeatherCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishConditions", reflect.TypeOf((*MockPublisher)(nil).PublishConditions), ctx, conditions)
-	return &MockPublisherPublishConditionsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentWeather", reflect.TypeOf((*MockBatchWeatherProvider)(nil).CurrentWeather), ctx, city, params)
+	return &MockBatchWeatherProviderCurrentWeatherCall{Call: call}
 }
 
-// MockPublisherPublishConditionsCall wrap *gomock.Call
-type MockPublisherPublishConditionsCall struct {
+// MockBatchWeatherProviderCurrentWeatherCall wrap *gomock.Call
+type MockBatchWeatherProviderCurrentWeatherCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockPublisherPublishConditionsCall) Return(arg0 error) *MockPublisherPublishConditionsCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockBatchWeatherProviderCurrentWeatherCall) Return(arg0 weather_service.CityWeatherCondition, arg1 error) *MockBatchWeatherProviderCurrentWeatherCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockPublisherPublishConditionsCall) Do(f func(context.Context, weather_service.CityWeatherConditions) error) *MockPublisherPublishConditionsCall {
+func (c *MockBatchWeatherProviderCurrentWeatherCall) Do(f func(context.Context, weather_service.City, weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error)) *MockBatchWeatherProviderCurrentWeatherCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockPublisherPublishConditionsCall) DoAndReturn(f func(context.Context, weather_service.CityWeatherConditions) error) *MockPublisherPublishConditionsCall {
+func (c *MockBatchWeatherProviderCurrentWeatherCall) DoAndReturn(f func(context.Context, weather_service.City, weather_service.MonitoringParamsMap) (weather_service.CityWeatherCondition, error)) *MockBatchWeatherProviderCurrentWeatherCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockStorage is a mock of Storage interface.
-type MockStorage struct {
+// CurrentWeatherBatch mocks base method.
+func (m *MockBatchWeatherProvider) CurrentWeatherBatch(ctx context.Context, cities weather_service.ReportedCities, params weather_service.MonitoringParamsMap) (weather_service.CityWeatherConditions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentWeatherBatch", ctx, cities, params)
+	ret0, _ := ret[0].(weather_service.CityWeatherConditions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentWeatherBatch indicates an expected call of CurrentWeatherBatch.
+func (mr *MockBatchWeatherProviderMockRecorder) CurrentWeatherBatch(ctx, cities, params any) *MockBatchWeatherProviderCurrentWeatherBatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentWeatherBatch", reflect.TypeOf((*MockBatchWeatherProvider)(nil).CurrentWeatherBatch), ctx, cities, params)
+	return &MockBatchWeatherProviderCurrentWeatherBatchCall{Call: call}
+}
+
+// MockBatchWeatherProviderCurrentWeatherBatchCall wrap *gomock.Call
+type MockBatchWeatherProviderCurrentWeatherBatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBatchWeatherProviderCurrentWeatherBatchCall) Return(arg0 weather_service.CityWeatherConditions, arg1 error) *MockBatchWeatherProviderCurrentWeatherBatchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBatchWeatherProviderCurrentWeatherBatchCall) Do(f func(context.Context, weather_service.ReportedCities, weather_service.MonitoringParamsMap) (weather_service.CityWeatherConditions, error)) *MockBatchWeatherProviderCurrentWeatherBatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBatchWeatherProviderCurrentWeatherBatchCall) DoAndReturn(f func(context.Context, weather_service.ReportedCities, weather_service.MonitoringParamsMap) (weather_service.CityWeatherConditions, error)) *MockBatchWeatherProviderCurrentWeatherBatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockProviderRegistry is a mock of ProviderRegistry interface.
+type MockProviderRegistry struct {
 	ctrl     *gomock.Controller
-	recorder *MockStorageMockRecorder
+	recorder *MockProviderRegistryMockRecorder
 	isgomock struct{}
 }
 
-// MockStorageMockRecorder is the mock recorder for MockStorage.
-type MockStorageMockRecorder struct {
-	mock *MockStorage
+// MockProviderRegistryMockRecorder is the mock recorder for MockProviderRegistry.
+type MockProviderRegistryMockRecorder struct {
+	mock *MockProviderRegistry
 }
 
-// NewMockStorage creates a new mock instance.
-func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
-	mock := &MockStorage{ctrl: ctrl}
-	mock.recorder = &MockStorageMockRecorder{mock}
+// NewMockProviderRegistry creates a new mock instance.
+func NewMockProviderRegistry(ctrl *gomock.Controller) *MockProviderRegistry {
+	mock := &MockProviderRegistry{ctrl: ctrl}
+	mock.recorder = &MockProviderRegistryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+func (m *MockProviderRegistry) EXPECT() *MockProviderRegistryMockRecorder {
 	return m.recorder
 }
 
-// GetConditions mocks base method.
-func (m *MockStorage) GetConditions(ctx context.Context) (weather_service.CityWeatherConditions, error) {
+// Provider mocks base method.
+func (m *MockProviderRegistry) Provider(name enums.Provider) (weather_service.WeatherProvider, bool) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConditions", ctx)
-	ret0, _ := ret[0].(weather_service.CityWeatherConditions)
-	ret1, _ := ret[1].(error)
+	ret := m.ctrl.Call(m, "Provider", name)
+	ret0, _ := ret[0].(weather_service.WeatherProvider)
+	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
 
-// GetConditions indicates an expected call of GetConditions.
-func (mr *MockStorageMockRecorder) GetConditions(ctx any) *MockStorageGetConditionsCall {
+// Provider indicates an expected call of Provider.
+func (mr *MockProviderRegistryMockRecorder) Provider(name any) *MockProviderRegistryProviderCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConditions", reflect.TypeOf((*MockStorage)(nil).GetConditions), ctx)
-	return &MockStorageGetConditionsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Provider", reflect.TypeOf((*MockProviderRegistry)(nil).Provider), name)
+	return &MockProviderRegistryProviderCall{Call: call}
 }
 
-// MockStorageGetConditionsCall wrap *gomock.Call
-type MockStorageGetConditionsCall struct {
+// MockProviderRegistryProviderCall wrap *gomock.Call
+type MockProviderRegistryProviderCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStorageGetConditionsCall) Return(arg0 weather_service.CityWeatherConditions, arg1 error) *MockStorageGetConditionsCall {
+func (c *MockProviderRegistryProviderCall) Return(arg0 weather_service.WeatherProvider, arg1 bool) *MockProviderRegistryProviderCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageGetConditionsCall) Do(f func(context.Context) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsCall {
+func (c *MockProviderRegistryProviderCall) Do(f func(enums.Provider) (weather_service.WeatherProvider, bool)) *MockProviderRegistryProviderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageGetConditionsCall) DoAndReturn(f func(context.Context) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsCall {
+func (c *MockProviderRegistryProviderCall) DoAndReturn(f func(enums.Provider) (weather_service.WeatherProvider, bool)) *MockProviderRegistryProviderCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveConditions mocks base method.
-func (m *MockStorage) SaveConditions(ctx context.Context, conditions weather_service.CityWeatherConditions) error {
+// MockForecastClient is a mock of ForecastClient interface.
+type MockForecastClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockForecastClientMockRecorder
+	isgomock struct{}
+}
+
+// MockForecastClientMockRecorder is the mock recorder for MockForecastClient.
+type MockForecastClientMockRecorder struct {
+	mock *MockForecastClient
+}
+
+// NewMockForecastClient creates a new mock instance.
+func NewMockForecastClient(ctrl *gomock.Controller) *MockForecastClient {
+	mock := &MockForecastClient{ctrl: ctrl}
+	mock.recorder = &MockForecastClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForecastClient) EXPECT() *MockForecastClientMockRecorder {
+	return m.recorder
+}
+
+// Forecast mocks base method.
+func (m *MockForecastClient) Forecast(ctx context.Context, city weather_service.City, params weather_service.ForecastParams) (weather_service.CityWeatherForecast, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveConditions", ctx, conditions)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Forecast", ctx, city, params)
+	ret0, _ := ret[0].(weather_service.CityWeatherForecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SaveConditions indicates an expected call of SaveConditions.
-func (mr *MockStorageMockRecorder) SaveConditions(ctx, conditions any) *MockStorageSaveConditionsCall {
+// Forecast indicates an expected call of Forecast.
+func (mr *MockForecastClientMockRecorder) Forecast(ctx, city, params any) *MockForecastClientForecastCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConditions", reflect.TypeOf((*MockStorage)(nil).SaveConditions), ctx, conditions)
-	return &MockStorageSaveConditionsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Forecast", reflect.TypeOf((*MockForecastClient)(nil).Forecast), ctx, city, params)
+	return &MockForecastClientForecastCall{Call: call}
 }
 
-// MockStorageSaveConditionsCall wrap *gomock.Call
-type MockStorageSaveConditionsCall struct {
+// MockForecastClientForecastCall wrap *gomock.Call
+type MockForecastClientForecastCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStorageSaveConditionsCall) Return(arg0 error) *MockStorageSaveConditionsCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockForecastClientForecastCall) Return(arg0 weather_service.CityWeatherForecast, arg1 error) *MockForecastClientForecastCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageSaveConditionsCall) Do(f func(context.Context, weather_service.CityWeatherConditions) error) *MockStorageSaveConditionsCall {
+func (c *MockForecastClientForecastCall) Do(f func(context.Context, weather_service.City, weather_service.ForecastParams) (weather_service.CityWeatherForecast, error)) *MockForecastClientForecastCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageSaveConditionsCall) DoAndReturn(f func(context.Context, weather_service.CityWeatherConditions) error) *MockStorageSaveConditionsCall {
+func (c *MockForecastClientForecastCall) DoAndReturn(f func(context.Context, weather_service.City, weather_service.ForecastParams) (weather_service.CityWeatherForecast, error)) *MockForecastClientForecastCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockPublisher is a mock of Publisher interface.
+type MockPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockPublisherMockRecorder is the mock recorder for MockPublisher.
+type MockPublisherMockRecorder struct {
+	mock *MockPublisher
+}
+
+// NewMockPublisher creates a new mock instance.
+func NewMockPublisher(ctrl *gomock.Controller) *MockPublisher {
+	mock := &MockPublisher{ctrl: ctrl}
+	mock.recorder = &MockPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublisher) EXPECT() *MockPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishConditions mocks base method.
+func (m *MockPublisher) PublishConditions(ctx context.Context, conditions weather_service.CityWeatherConditions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishConditions", ctx, conditions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishConditions indicates an expected call of PublishConditions.
+func (mr *MockPublisherMockRecorder) PublishConditions(ctx, conditions any) *MockPublisherPublishConditionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishConditions", reflect.TypeOf((*MockPublisher)(nil).PublishConditions), ctx, conditions)
+	return &MockPublisherPublishConditionsCall{Call: call}
+}
+
+// MockPublisherPublishConditionsCall wrap *gomock.Call
+type MockPublisherPublishConditionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPublisherPublishConditionsCall) Return(arg0 error) *MockPublisherPublishConditionsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPublisherPublishConditionsCall) Do(f func(context.Context, weather_service.CityWeatherConditions) error) *MockPublisherPublishConditionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPublisherPublishConditionsCall) DoAndReturn(f func(context.Context, weather_service.CityWeatherConditions) error) *MockPublisherPublishConditionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PublishForecasts mocks base method.
+func (m *MockPublisher) PublishForecasts(ctx context.Context, forecasts weather_service.CityWeatherForecasts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishForecasts", ctx, forecasts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishForecasts indicates an expected call of PublishForecasts.
+func (mr *MockPublisherMockRecorder) PublishForecasts(ctx, forecasts any) *MockPublisherPublishForecastsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishForecasts", reflect.TypeOf((*MockPublisher)(nil).PublishForecasts), ctx, forecasts)
+	return &MockPublisherPublishForecastsCall{Call: call}
+}
+
+// MockPublisherPublishForecastsCall wrap *gomock.Call
+type MockPublisherPublishForecastsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPublisherPublishForecastsCall) Return(arg0 error) *MockPublisherPublishForecastsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPublisherPublishForecastsCall) Do(f func(context.Context, weather_service.CityWeatherForecasts) error) *MockPublisherPublishForecastsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPublisherPublishForecastsCall) DoAndReturn(f func(context.Context, weather_service.CityWeatherForecasts) error) *MockPublisherPublishForecastsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// GetConditions mocks base method.
+func (m *MockStorage) GetConditions(ctx context.Context) (weather_service.CityWeatherConditions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConditions", ctx)
+	ret0, _ := ret[0].(weather_service.CityWeatherConditions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConditions indicates an expected call of GetConditions.
+func (mr *MockStorageMockRecorder) GetConditions(ctx any) *MockStorageGetConditionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConditions", reflect.TypeOf((*MockStorage)(nil).GetConditions), ctx)
+	return &MockStorageGetConditionsCall{Call: call}
+}
+
+// MockStorageGetConditionsCall wrap *gomock.Call
+type MockStorageGetConditionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetConditionsCall) Return(arg0 weather_service.CityWeatherConditions, arg1 error) *MockStorageGetConditionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetConditionsCall) Do(f func(context.Context) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetConditionsCall) DoAndReturn(f func(context.Context) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPendingOutbox mocks base method.
+func (m *MockStorage) GetPendingOutbox(ctx context.Context, limit int) (weather_service.OutboxEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOutbox", ctx, limit)
+	ret0, _ := ret[0].(weather_service.OutboxEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOutbox indicates an expected call of GetPendingOutbox.
+func (mr *MockStorageMockRecorder) GetPendingOutbox(ctx, limit any) *MockStorageGetPendingOutboxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOutbox", reflect.TypeOf((*MockStorage)(nil).GetPendingOutbox), ctx, limit)
+	return &MockStorageGetPendingOutboxCall{Call: call}
+}
+
+// MockStorageGetPendingOutboxCall wrap *gomock.Call
+type MockStorageGetPendingOutboxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetPendingOutboxCall) Return(arg0 weather_service.OutboxEntries, arg1 error) *MockStorageGetPendingOutboxCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetPendingOutboxCall) Do(f func(context.Context, int) (weather_service.OutboxEntries, error)) *MockStorageGetPendingOutboxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetPendingOutboxCall) DoAndReturn(f func(context.Context, int) (weather_service.OutboxEntries, error)) *MockStorageGetPendingOutboxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPublishedFingerprints mocks base method.
+func (m *MockStorage) GetPublishedFingerprints(ctx context.Context) (weather_service.Fingerprints, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedFingerprints", ctx)
+	ret0, _ := ret[0].(weather_service.Fingerprints)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedFingerprints indicates an expected call of GetPublishedFingerprints.
+func (mr *MockStorageMockRecorder) GetPublishedFingerprints(ctx any) *MockStorageGetPublishedFingerprintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedFingerprints", reflect.TypeOf((*MockStorage)(nil).GetPublishedFingerprints), ctx)
+	return &MockStorageGetPublishedFingerprintsCall{Call: call}
+}
+
+// MockStorageGetPublishedFingerprintsCall wrap *gomock.Call
+type MockStorageGetPublishedFingerprintsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetPublishedFingerprintsCall) Return(arg0 weather_service.Fingerprints, arg1 error) *MockStorageGetPublishedFingerprintsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetPublishedFingerprintsCall) Do(f func(context.Context) (weather_service.Fingerprints, error)) *MockStorageGetPublishedFingerprintsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetPublishedFingerprintsCall) DoAndReturn(f func(context.Context) (weather_service.Fingerprints, error)) *MockStorageGetPublishedFingerprintsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkOutboxFailed mocks base method.
+func (m *MockStorage) MarkOutboxFailed(ctx context.Context, ids []int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxFailed", ctx, ids, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxFailed indicates an expected call of MarkOutboxFailed.
+func (mr *MockStorageMockRecorder) MarkOutboxFailed(ctx, ids, reason any) *MockStorageMarkOutboxFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxFailed", reflect.TypeOf((*MockStorage)(nil).MarkOutboxFailed), ctx, ids, reason)
+	return &MockStorageMarkOutboxFailedCall{Call: call}
+}
+
+// MockStorageMarkOutboxFailedCall wrap *gomock.Call
+type MockStorageMarkOutboxFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageMarkOutboxFailedCall) Return(arg0 error) *MockStorageMarkOutboxFailedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageMarkOutboxFailedCall) Do(f func(context.Context, []int64, string) error) *MockStorageMarkOutboxFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageMarkOutboxFailedCall) DoAndReturn(f func(context.Context, []int64, string) error) *MockStorageMarkOutboxFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkOutboxPublished mocks base method.
+func (m *MockStorage) MarkOutboxPublished(ctx context.Context, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxPublished", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxPublished indicates an expected call of MarkOutboxPublished.
+func (mr *MockStorageMockRecorder) MarkOutboxPublished(ctx, ids any) *MockStorageMarkOutboxPublishedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxPublished", reflect.TypeOf((*MockStorage)(nil).MarkOutboxPublished), ctx, ids)
+	return &MockStorageMarkOutboxPublishedCall{Call: call}
+}
+
+// MockStorageMarkOutboxPublishedCall wrap *gomock.Call
+type MockStorageMarkOutboxPublishedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageMarkOutboxPublishedCall) Return(arg0 error) *MockStorageMarkOutboxPublishedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageMarkOutboxPublishedCall) Do(f func(context.Context, []int64) error) *MockStorageMarkOutboxPublishedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageMarkOutboxPublishedCall) DoAndReturn(f func(context.Context, []int64) error) *MockStorageMarkOutboxPublishedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ParkOutbox mocks base method.
+func (m *MockStorage) ParkOutbox(ctx context.Context, ids []int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParkOutbox", ctx, ids, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ParkOutbox indicates an expected call of ParkOutbox.
+func (mr *MockStorageMockRecorder) ParkOutbox(ctx, ids, reason any) *MockStorageParkOutboxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParkOutbox", reflect.TypeOf((*MockStorage)(nil).ParkOutbox), ctx, ids, reason)
+	return &MockStorageParkOutboxCall{Call: call}
+}
+
+// MockStorageParkOutboxCall wrap *gomock.Call
+type MockStorageParkOutboxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageParkOutboxCall) Return(arg0 error) *MockStorageParkOutboxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageParkOutboxCall) Do(f func(context.Context, []int64, string) error) *MockStorageParkOutboxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageParkOutboxCall) DoAndReturn(f func(context.Context, []int64, string) error) *MockStorageParkOutboxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveConditions mocks base method.
+func (m *MockStorage) SaveConditions(ctx context.Context, conditions weather_service.CityWeatherConditions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveConditions", ctx, conditions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConditions indicates an expected call of SaveConditions.
+func (mr *MockStorageMockRecorder) SaveConditions(ctx, conditions any) *MockStorageSaveConditionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConditions", reflect.TypeOf((*MockStorage)(nil).SaveConditions), ctx, conditions)
+	return &MockStorageSaveConditionsCall{Call: call}
+}
+
+// MockStorageSaveConditionsCall wrap *gomock.Call
+type MockStorageSaveConditionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSaveConditionsCall) Return(arg0 error) *MockStorageSaveConditionsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSaveConditionsCall) Do(f func(context.Context, weather_service.CityWeatherConditions) error) *MockStorageSaveConditionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSaveConditionsCall) DoAndReturn(f func(context.Context, weather_service.CityWeatherConditions) error) *MockStorageSaveConditionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveForecasts mocks base method.
+func (m *MockStorage) SaveForecasts(ctx context.Context, forecasts weather_service.CityWeatherForecasts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveForecasts", ctx, forecasts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveForecasts indicates an expected call of SaveForecasts.
+func (mr *MockStorageMockRecorder) SaveForecasts(ctx, forecasts any) *MockStorageSaveForecastsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveForecasts", reflect.TypeOf((*MockStorage)(nil).SaveForecasts), ctx, forecasts)
+	return &MockStorageSaveForecastsCall{Call: call}
+}
+
+// MockStorageSaveForecastsCall wrap *gomock.Call
+type MockStorageSaveForecastsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSaveForecastsCall) Return(arg0 error) *MockStorageSaveForecastsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSaveForecastsCall) Do(f func(context.Context, weather_service.CityWeatherForecasts) error) *MockStorageSaveForecastsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSaveForecastsCall) DoAndReturn(f func(context.Context, weather_service.CityWeatherForecasts) error) *MockStorageSaveForecastsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SavePublishedFingerprints mocks base method.
+func (m *MockStorage) SavePublishedFingerprints(ctx context.Context, fingerprints weather_service.Fingerprints) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePublishedFingerprints", ctx, fingerprints)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePublishedFingerprints indicates an expected call of SavePublishedFingerprints.
+func (mr *MockStorageMockRecorder) SavePublishedFingerprints(ctx, fingerprints any) *MockStorageSavePublishedFingerprintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePublishedFingerprints", reflect.TypeOf((*MockStorage)(nil).SavePublishedFingerprints), ctx, fingerprints)
+	return &MockStorageSavePublishedFingerprintsCall{Call: call}
+}
+
+// MockStorageSavePublishedFingerprintsCall wrap *gomock.Call
+type MockStorageSavePublishedFingerprintsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSavePublishedFingerprintsCall) Return(arg0 error) *MockStorageSavePublishedFingerprintsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSavePublishedFingerprintsCall) Do(f func(context.Context, weather_service.Fingerprints) error) *MockStorageSavePublishedFingerprintsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSavePublishedFingerprintsCall) DoAndReturn(f func(context.Context, weather_service.Fingerprints) error) *MockStorageSavePublishedFingerprintsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockAlerter is a mock of Alerter interface.
+type MockAlerter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlerterMockRecorder
+	isgomock struct{}
+}
+
+// MockAlerterMockRecorder is the mock recorder for MockAlerter.
+type MockAlerterMockRecorder struct {
+	mock *MockAlerter
+}
+
+// NewMockAlerter creates a new mock instance.
+func NewMockAlerter(ctrl *gomock.Controller) *MockAlerter {
+	mock := &MockAlerter{ctrl: ctrl}
+	mock.recorder = &MockAlerterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlerter) EXPECT() *MockAlerterMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockAlerter) Evaluate(ctx context.Context, conditions weather_service.CityWeatherConditions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, conditions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockAlerterMockRecorder) Evaluate(ctx, conditions any) *MockAlerterEvaluateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockAlerter)(nil).Evaluate), ctx, conditions)
+	return &MockAlerterEvaluateCall{Call: call}
+}
+
+// MockAlerterEvaluateCall wrap *gomock.Call
+type MockAlerterEvaluateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAlerterEvaluateCall) Return(arg0 error) *MockAlerterEvaluateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAlerterEvaluateCall) Do(f func(context.Context, weather_service.CityWeatherConditions) error) *MockAlerterEvaluateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAlerterEvaluateCall) DoAndReturn(f func(context.Context, weather_service.CityWeatherConditions) error) *MockAlerterEvaluateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockBroadcaster is a mock of Broadcaster interface.
+type MockBroadcaster struct {
+	ctrl     *gomock.Controller
+	recorder *MockBroadcasterMockRecorder
+	isgomock struct{}
+}
+
+// MockBroadcasterMockRecorder is the mock recorder for MockBroadcaster.
+type MockBroadcasterMockRecorder struct {
+	mock *MockBroadcaster
+}
+
+// NewMockBroadcaster creates a new mock instance.
+func NewMockBroadcaster(ctrl *gomock.Controller) *MockBroadcaster {
+	mock := &MockBroadcaster{ctrl: ctrl}
+	mock.recorder = &MockBroadcasterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroadcaster) EXPECT() *MockBroadcasterMockRecorder {
+	return m.recorder
+}
+
+// Broadcast mocks base method.
+func (m *MockBroadcaster) Broadcast(ctx context.Context, conditions weather_service.CityWeatherConditions) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Broadcast", ctx, conditions)
+}
+
+// Broadcast indicates an expected call of Broadcast.
+func (mr *MockBroadcasterMockRecorder) Broadcast(ctx, conditions any) *MockBroadcasterBroadcastCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Broadcast", reflect.TypeOf((*MockBroadcaster)(nil).Broadcast), ctx, conditions)
+	return &MockBroadcasterBroadcastCall{Call: call}
+}
+
+// MockBroadcasterBroadcastCall wrap *gomock.Call
+type MockBroadcasterBroadcastCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBroadcasterBroadcastCall) Return() *MockBroadcasterBroadcastCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBroadcasterBroadcastCall) Do(f func(context.Context, weather_service.CityWeatherConditions)) *MockBroadcasterBroadcastCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBroadcasterBroadcastCall) DoAndReturn(f func(context.Context, weather_service.CityWeatherConditions)) *MockBroadcasterBroadcastCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,70 @@
+package grpc_api
+
+import (
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	weather_collector_events "github.com/meteogo/weather-collector-service/pkg/events"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mapCondition fills the measurements in the given params, all of them when
+// params is empty. Only the fields expressed in units are filled, the
+// fixed-unit millimetre fields are left to the Kafka events.
+func mapCondition(c weather_service.CityWeatherCondition, units enums.UnitSystem, params map[enums.MonitoringParam]struct{}) *weather_collector_events.CityWeatherCondition {
+	wanted := func(param enums.MonitoringParam) bool {
+		_, ok := params[param]
+		return len(params) == 0 || ok
+	}
+
+	condition := &weather_collector_events.CityWeatherCondition{
+		City: &weather_collector_events.City{
+			Id:   c.City.ID,
+			Name: c.City.Name,
+			Coordinates: &weather_collector_events.Coordinates{
+				Lat:  c.City.Lat,
+				Long: c.City.Long,
+			},
+		},
+		CapturedAt: timestamppb.New(c.CapturedAt.UTC()),
+		Provider:   string(c.Provider),
+		Units: &weather_collector_events.MeasurementUnits{
+			Temperature:   string(units.TemperatureUnit()),
+			WindSpeed:     string(units.WindSpeedUnit()),
+			Precipitation: string(units.PrecipitationUnit()),
+			Visibility:    string(units.VisibilityUnit()),
+		},
+	}
+
+	if wanted(enums.MonitoringParamTemperature) {
+		condition.Temperature = ptr.Map(c.Temperature, func(v enums.Temperature) float64 { return v.In(units.TemperatureUnit()) })
+	}
+
+	if wanted(enums.MonitoringParamRelativeHumidity) {
+		condition.RelativeHumidityPercent = ptr.Map(c.RelativeHumidityPercent, func(v uint8) uint32 { return uint32(v) })
+	}
+
+	if wanted(enums.MonitoringParamWindSpeed) {
+		condition.WindSpeed = ptr.Map(c.WindSpeed, func(v enums.Speed) float64 { return v.In(units.WindSpeedUnit()) })
+	}
+
+	if wanted(enums.MonitoringParamWeatherCode) {
+		condition.WeatherCode = ptr.Map(c.WeatherCode, func(v enums.WeatherCode) weather_collector_events.WeatherCode {
+			return weather_collector_events.WeatherCode(v)
+		})
+	}
+
+	if wanted(enums.MonitoringParamCloudCover) {
+		condition.CloudCoverPercent = ptr.Map(c.CloudCoverPercent, func(v uint8) uint32 { return uint32(v) })
+	}
+
+	if wanted(enums.MonitoringParamPrecipitation) {
+		condition.Precipitation = ptr.Map(c.Precipitation, func(v enums.Length) float64 { return v.In(units.PrecipitationUnit()) })
+	}
+
+	if wanted(enums.MonitoringParamVisibility) {
+		condition.Visibility = ptr.Map(c.Visibility, func(v enums.Length) float64 { return v.In(units.VisibilityUnit()) })
+	}
+
+	return condition
+}
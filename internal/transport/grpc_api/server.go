@@ -0,0 +1,68 @@
+package grpc_api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+
+	"github.com/meteogo/logger/pkg/logger"
+	weather_collector_events "github.com/meteogo/weather-collector-service/pkg/events"
+	"google.golang.org/grpc"
+)
+
+type Server struct {
+	addr     string
+	server   *grpc.Server
+	stopping chan struct{}
+	stopOnce sync.Once
+}
+
+func NewServer(addr string, storage Storage, subscriptions Subscriptions) *Server {
+	s := &Server{
+		addr:     addr,
+		server:   grpc.NewServer(),
+		stopping: make(chan struct{}),
+	}
+
+	weather_collector_events.RegisterWeatherCollectorServiceServer(s.server, NewService(storage, subscriptions, s.stopping))
+	return s
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil {
+			logger.Error(ctx, "grpc api server stopped", slog.Any("error", err))
+		}
+	}()
+
+	logger.Info(ctx, fmt.Sprintf("[%T.Start] grpc api server started", s), slog.String("addr", s.addr))
+	return nil
+}
+
+// Stop ends the open subscriptions and waits for the running calls, falling
+// back to a hard stop when ctx is done first. It is safe to call more than
+// once.
+func (s *Server) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stopping) })
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.server.Stop()
+	}
+
+	return nil
+}
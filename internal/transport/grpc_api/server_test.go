@@ -0,0 +1,27 @@
+package grpc_api_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/transport/grpc_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Stop(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	server := grpc_api.NewServer("127.0.0.1:0", nil, nil)
+	require.NoError(t, server.Start(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	assert.NoError(t, server.Stop(ctx))
+	assert.NotPanics(t, func() { assert.NoError(t, server.Stop(ctx)) })
+}
@@ -0,0 +1,263 @@
+package grpc_api
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/subscription_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	weather_collector_events "github.com/meteogo/weather-collector-service/pkg/events"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate mockgen -source service.go -destination service_mocks_test.go -package grpc_api_test -typed
+
+const defaultPageSize = 50
+
+type Storage interface {
+	GetConditionsPage(ctx context.Context, afterCityID int64, limit int) (weather_service.CityWeatherConditions, error)
+	GetCityCondition(ctx context.Context, cityID int64) (weather_service.CityWeatherCondition, bool, error)
+}
+
+type Subscriptions interface {
+	Subscribe(cityIDs []int64) *subscription_service.Subscription
+}
+
+type Service struct {
+	weather_collector_events.UnimplementedWeatherCollectorServiceServer
+
+	storage       Storage
+	subscriptions Subscriptions
+	stopping      <-chan struct{}
+}
+
+// NewService serves the API. Open subscriptions end with Unavailable once
+// stopping is closed, they would hold a graceful stop forever otherwise.
+func NewService(storage Storage, subscriptions Subscriptions, stopping <-chan struct{}) *Service {
+	return &Service{
+		storage:       storage,
+		subscriptions: subscriptions,
+		stopping:      stopping,
+	}
+}
+
+func (s *Service) GetCurrent(ctx context.Context, req *weather_collector_events.GetCurrentRequest) (*weather_collector_events.GetCurrentResponse, error) {
+	ctx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.GetCurrent]", s))
+	defer span.End()
+
+	span.SetAttributes(telemetry.CityIDKey.Int64(req.GetCityId()))
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	units, err := parseUnits(req.GetUnits())
+	if err != nil {
+		return nil, err
+	}
+
+	condition, ok, err := s.storage.GetCityCondition(ctx, req.GetCityId())
+	if err != nil {
+		logger.Error(ctx, "unable to get city condition", slog.Any("error", err))
+		return nil, status.Error(codes.Internal, telemetry.Fail(span, err).Error())
+	}
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "city %d has no conditions", req.GetCityId())
+	}
+
+	return &weather_collector_events.GetCurrentResponse{
+		Condition: mapCondition(condition, units, nil),
+	}, nil
+}
+
+func (s *Service) ListCurrent(ctx context.Context, req *weather_collector_events.ListCurrentRequest) (*weather_collector_events.ListCurrentResponse, error) {
+	ctx, span := otel.Tracer("").Start(ctx, fmt.Sprintf("[%T.ListCurrent]", s))
+	defer span.End()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	units, err := parseUnits(req.GetUnits())
+	if err != nil {
+		return nil, err
+	}
+
+	afterCityID, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+
+	conditions, err := s.storage.GetConditionsPage(ctx, afterCityID, limit+1)
+	if err != nil {
+		logger.Error(ctx, "unable to get conditions page", slog.Any("error", err))
+		return nil, status.Error(codes.Internal, telemetry.Fail(span, err).Error())
+	}
+
+	response := &weather_collector_events.ListCurrentResponse{}
+	if len(conditions) > limit {
+		conditions = conditions[:limit]
+		response.NextPageToken = encodePageToken(conditions[limit-1].City.ID)
+	}
+
+	response.Conditions = make([]*weather_collector_events.CityWeatherCondition, 0, len(conditions))
+	for _, condition := range conditions {
+		response.Conditions = append(response.Conditions, mapCondition(condition, units, nil))
+	}
+
+	return response, nil
+}
+
+// Subscribe sends the latest stored reading of the requested cities and then
+// every new one until the client goes away. A client too slow to read them
+// is dropped with ResourceExhausted.
+//
+// New readings are handed over in process: with several replicas a
+// subscription only gets the readings collected by the replica serving it,
+// the stored readings it starts with may come from any of them.
+func (s *Service) Subscribe(req *weather_collector_events.SubscribeRequest, stream grpc.ServerStreamingServer[weather_collector_events.CityWeatherCondition]) error {
+	ctx := stream.Context()
+
+	if err := req.ValidateAll(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	units, err := parseUnits(req.GetUnits())
+	if err != nil {
+		return err
+	}
+
+	params := make(map[enums.MonitoringParam]struct{}, len(req.GetParams()))
+	for _, param := range req.GetParams() {
+		params[enums.MonitoringParam(param)] = struct{}{}
+	}
+
+	// Subscribing before reading the stored readings leaves no gap between
+	// the two, a reading that shows up in both is sent once.
+	sub := s.subscriptions.Subscribe(req.GetCityIds())
+	defer sub.Close()
+
+	latest, err := s.latestConditions(ctx, req.GetCityIds())
+	if err != nil {
+		logger.Error(ctx, "unable to get latest conditions", slog.Any("error", err))
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	sent := make(map[int64]time.Time, len(latest))
+	for _, condition := range latest {
+		if err := stream.Send(mapCondition(condition, units, params)); err != nil {
+			return err
+		}
+
+		sent[condition.City.ID] = condition.CapturedAt
+	}
+
+	logger.Info(ctx, "subscription started", slog.Any("cityIDs", req.GetCityIds()), slog.Any("params", req.GetParams()))
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "subscription ended by client")
+			return nil
+		case <-s.stopping:
+			return status.Error(codes.Unavailable, "server is stopping")
+		case condition, ok := <-sub.Updates():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, sub.Err().Error())
+			}
+
+			if !condition.CapturedAt.After(sent[condition.City.ID]) {
+				continue
+			}
+
+			if err := stream.Send(mapCondition(condition, units, params)); err != nil {
+				return err
+			}
+
+			sent[condition.City.ID] = condition.CapturedAt
+		}
+	}
+}
+
+// latestConditions returns the stored readings of the cities, of every city
+// when none is given.
+func (s *Service) latestConditions(ctx context.Context, cityIDs []int64) (weather_service.CityWeatherConditions, error) {
+	var conditions weather_service.CityWeatherConditions
+
+	if len(cityIDs) > 0 {
+		for _, cityID := range cityIDs {
+			condition, ok, err := s.storage.GetCityCondition(ctx, cityID)
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				conditions = append(conditions, condition)
+			}
+		}
+
+		return conditions, nil
+	}
+
+	var afterCityID int64
+	for {
+		page, err := s.storage.GetConditionsPage(ctx, afterCityID, defaultPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		conditions = append(conditions, page...)
+		if len(page) < defaultPageSize {
+			return conditions, nil
+		}
+
+		afterCityID = page[len(page)-1].City.ID
+	}
+}
+
+func parseUnits(value string) (enums.UnitSystem, error) {
+	if value == "" {
+		return enums.UnitSystemMetric, nil
+	}
+
+	units, err := enums.ParseUnitSystem(value)
+	if err != nil {
+		return enums.UnitSystem{}, status.Errorf(codes.InvalidArgument, "invalid units: %s", err)
+	}
+
+	return units, nil
+}
+
+// Page tokens are opaque to clients, they carry the id of the last city of
+// the previous page.
+func encodePageToken(afterCityID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(afterCityID, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	after, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(string(after), 10, 64)
+}
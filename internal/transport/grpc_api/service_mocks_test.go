@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source service.go -destination service_mocks_test.go -package grpc_api_test -typed
+//
+
+// Package grpc_api_test is a generated GoMock package.
+package grpc_api_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	subscription_service "github.com/meteogo/weather-collector-service/internal/services/subscription_service"
+	weather_service "github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// GetCityCondition mocks base method.
+func (m *MockStorage) GetCityCondition(ctx context.Context, cityID int64) (weather_service.CityWeatherCondition, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCityCondition", ctx, cityID)
+	ret0, _ := ret[0].(weather_service.CityWeatherCondition)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCityCondition indicates an expected call of GetCityCondition.
+func (mr *MockStorageMockRecorder) GetCityCondition(ctx, cityID any) *MockStorageGetCityConditionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCityCondition", reflect.TypeOf((*MockStorage)(nil).GetCityCondition), ctx, cityID)
+	return &MockStorageGetCityConditionCall{Call: call}
+}
+
+// MockStorageGetCityConditionCall wrap *gomock.Call
+type MockStorageGetCityConditionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetCityConditionCall) Return(arg0 weather_service.CityWeatherCondition, arg1 bool, arg2 error) *MockStorageGetCityConditionCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetCityConditionCall) Do(f func(context.Context, int64) (weather_service.CityWeatherCondition, bool, error)) *MockStorageGetCityConditionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetCityConditionCall) DoAndReturn(f func(context.Context, int64) (weather_service.CityWeatherCondition, bool, error)) *MockStorageGetCityConditionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetConditionsPage mocks base method.
+func (m *MockStorage) GetConditionsPage(ctx context.Context, afterCityID int64, limit int) (weather_service.CityWeatherConditions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConditionsPage", ctx, afterCityID, limit)
+	ret0, _ := ret[0].(weather_service.CityWeatherConditions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConditionsPage indicates an expected call of GetConditionsPage.
+func (mr *MockStorageMockRecorder) GetConditionsPage(ctx, afterCityID, limit any) *MockStorageGetConditionsPageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConditionsPage", reflect.TypeOf((*MockStorage)(nil).GetConditionsPage), ctx, afterCityID, limit)
+	return &MockStorageGetConditionsPageCall{Call: call}
+}
+
+// MockStorageGetConditionsPageCall wrap *gomock.Call
+type MockStorageGetConditionsPageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetConditionsPageCall) Return(arg0 weather_service.CityWeatherConditions, arg1 error) *MockStorageGetConditionsPageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetConditionsPageCall) Do(f func(context.Context, int64, int) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsPageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetConditionsPageCall) DoAndReturn(f func(context.Context, int64, int) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsPageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSubscriptions is a mock of Subscriptions interface.
+type MockSubscriptions struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionsMockRecorder
+	isgomock struct{}
+}
+
+// MockSubscriptionsMockRecorder is the mock recorder for MockSubscriptions.
+type MockSubscriptionsMockRecorder struct {
+	mock *MockSubscriptions
+}
+
+// NewMockSubscriptions creates a new mock instance.
+func NewMockSubscriptions(ctrl *gomock.Controller) *MockSubscriptions {
+	mock := &MockSubscriptions{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptions) EXPECT() *MockSubscriptionsMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockSubscriptions) Subscribe(cityIDs []int64) *subscription_service.Subscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", cityIDs)
+	ret0, _ := ret[0].(*subscription_service.Subscription)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSubscriptionsMockRecorder) Subscribe(cityIDs any) *MockSubscriptionsSubscribeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSubscriptions)(nil).Subscribe), cityIDs)
+	return &MockSubscriptionsSubscribeCall{Call: call}
+}
+
+// MockSubscriptionsSubscribeCall wrap *gomock.Call
+type MockSubscriptionsSubscribeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSubscriptionsSubscribeCall) Return(arg0 *subscription_service.Subscription) *MockSubscriptionsSubscribeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSubscriptionsSubscribeCall) Do(f func([]int64) *subscription_service.Subscription) *MockSubscriptionsSubscribeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSubscriptionsSubscribeCall) DoAndReturn(f func([]int64) *subscription_service.Subscription) *MockSubscriptionsSubscribeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,310 @@
+package grpc_api_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/subscription_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/meteogo/weather-collector-service/internal/transport/grpc_api"
+	weather_collector_events "github.com/meteogo/weather-collector-service/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var (
+	capturedAt = time.Date(2025, 7, 10, 12, 0, 0, 0, time.UTC)
+
+	berlinCondition = weather_service.CityWeatherCondition{
+		City: weather_service.City{
+			ID:          1,
+			Name:        "Berlin",
+			Coordinates: weather_service.Coordinates{Lat: 52.52, Long: 13.41},
+		},
+		CapturedAt:  capturedAt,
+		Temperature: ptr.To(enums.Celsius(20)),
+		WindSpeed:   ptr.To(enums.KilometersPerHour(16.09344)),
+		Provider:    enums.ProviderOpenMeteo,
+	}
+
+	parisCondition = weather_service.CityWeatherCondition{
+		City: weather_service.City{
+			ID:          2,
+			Name:        "Paris",
+			Coordinates: weather_service.Coordinates{Lat: 48.86, Long: 2.35},
+		},
+		CapturedAt: capturedAt,
+		Provider:   enums.ProviderMetNorway,
+	}
+)
+
+func newClient(t *testing.T, storage grpc_api.Storage, subscriptions grpc_api.Subscriptions, stopping <-chan struct{}) weather_collector_events.WeatherCollectorServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	weather_collector_events.RegisterWeatherCollectorServiceServer(server, grpc_api.NewService(storage, subscriptions, stopping))
+
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return weather_collector_events.NewWeatherCollectorServiceClient(conn)
+}
+
+func TestService_GetCurrent(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	tests := []struct {
+		name     string
+		req      *weather_collector_events.GetCurrentRequest
+		storage  func(ctrl *gomock.Controller) grpc_api.Storage
+		wantCode codes.Code
+	}{
+		{
+			name: "imperial units",
+			req:  &weather_collector_events.GetCurrentRequest{CityId: 1, Units: "imperial"},
+			storage: func(ctrl *gomock.Controller) grpc_api.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetCityCondition(gomock.Any(), int64(1)).
+					Return(berlinCondition, true, nil).
+					Times(1)
+
+				return mock
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "unknown city",
+			req:  &weather_collector_events.GetCurrentRequest{CityId: 4},
+			storage: func(ctrl *gomock.Controller) grpc_api.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetCityCondition(gomock.Any(), int64(4)).
+					Return(weather_service.CityWeatherCondition{}, false, nil).
+					Times(1)
+
+				return mock
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "missing city id",
+			req:  &weather_collector_events.GetCurrentRequest{},
+			storage: func(ctrl *gomock.Controller) grpc_api.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid units",
+			req:  &weather_collector_events.GetCurrentRequest{CityId: 1, Units: "nautical"},
+			storage: func(ctrl *gomock.Controller) grpc_api.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "storage error",
+			req:  &weather_collector_events.GetCurrentRequest{CityId: 1},
+			storage: func(ctrl *gomock.Controller) grpc_api.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetCityCondition(gomock.Any(), int64(1)).
+					Return(weather_service.CityWeatherCondition{}, false, errors.New("connection refused")).
+					Times(1)
+
+				return mock
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			client := newClient(t, tt.storage(ctrl), nil, nil)
+
+			response, err := client.GetCurrent(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			condition := response.GetCondition()
+			assert.Equal(t, "Berlin", condition.GetCity().GetName())
+			assert.InDelta(t, 68, condition.GetTemperature(), 1e-9)
+			assert.InDelta(t, 10, condition.GetWindSpeed(), 1e-9)
+			assert.Nil(t, condition.Precipitation)
+			assert.Equal(t, string(enums.UnitFahrenheit), condition.GetUnits().GetTemperature())
+		})
+	}
+}
+
+func TestService_ListCurrent(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	ctrl := gomock.NewController(t)
+	storage := NewMockStorage(ctrl)
+	storage.EXPECT().
+		GetConditionsPage(gomock.Any(), int64(0), 2).
+		Return(weather_service.CityWeatherConditions{berlinCondition, parisCondition}, nil).
+		Times(1)
+	storage.EXPECT().
+		GetConditionsPage(gomock.Any(), int64(1), 2).
+		Return(weather_service.CityWeatherConditions{parisCondition}, nil).
+		Times(1)
+
+	client := newClient(t, storage, nil, nil)
+
+	first, err := client.ListCurrent(context.Background(), &weather_collector_events.ListCurrentRequest{PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, first.GetConditions(), 1)
+	assert.Equal(t, "Berlin", first.GetConditions()[0].GetCity().GetName())
+	require.NotEmpty(t, first.GetNextPageToken())
+
+	second, err := client.ListCurrent(context.Background(), &weather_collector_events.ListCurrentRequest{PageSize: 1, PageToken: first.GetNextPageToken()})
+	require.NoError(t, err)
+	require.Len(t, second.GetConditions(), 1)
+	assert.Equal(t, "Paris", second.GetConditions()[0].GetCity().GetName())
+	assert.Empty(t, second.GetNextPageToken())
+
+	_, err = client.ListCurrent(context.Background(), &weather_collector_events.ListCurrentRequest{PageToken: "%%%"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestService_Subscribe(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	ctrl := gomock.NewController(t)
+	storage := NewMockStorage(ctrl)
+	storage.EXPECT().
+		GetCityCondition(gomock.Any(), int64(1)).
+		Return(berlinCondition, true, nil).
+		Times(1)
+
+	subscriptions := subscription_service.NewService(4)
+	stopping := make(chan struct{})
+	client := newClient(t, storage, subscriptions, stopping)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.Subscribe(ctx, &weather_collector_events.SubscribeRequest{
+		CityIds: []int64{1},
+		Params:  []string{string(enums.MonitoringParamTemperature)},
+	})
+	require.NoError(t, err)
+
+	stored, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "Berlin", stored.GetCity().GetName())
+	assert.Equal(t, capturedAt, stored.GetCapturedAt().AsTime())
+	assert.InDelta(t, 20, stored.GetTemperature(), 1e-9)
+	assert.Nil(t, stored.WindSpeed)
+
+	// The subscription is registered once the stored reading was sent. The
+	// first broadcast repeats that reading and must be skipped, keep
+	// broadcasting fresh readings until one arrives.
+	received := make(chan *weather_collector_events.CityWeatherCondition)
+	go func() {
+		condition, err := stream.Recv()
+		if err == nil {
+			received <- condition
+		}
+		close(received)
+	}()
+
+	var condition *weather_collector_events.CityWeatherCondition
+	for tick := time.Duration(0); condition == nil; tick += time.Millisecond {
+		berlin := berlinCondition
+		berlin.CapturedAt = capturedAt.Add(tick)
+		subscriptions.Broadcast(ctx, weather_service.CityWeatherConditions{parisCondition, berlin})
+
+		select {
+		case condition = <-received:
+			require.NotNil(t, condition)
+		case <-time.After(10 * time.Millisecond):
+		case <-ctx.Done():
+			t.Fatal("no update received")
+		}
+	}
+
+	assert.Equal(t, "Berlin", condition.GetCity().GetName())
+	assert.True(t, condition.GetCapturedAt().AsTime().After(capturedAt))
+
+	close(stopping)
+	for {
+		if _, err = stream.Recv(); err != nil {
+			break
+		}
+	}
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestService_SubscribeAllCities(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	ctrl := gomock.NewController(t)
+	storage := NewMockStorage(ctrl)
+	storage.EXPECT().
+		GetConditionsPage(gomock.Any(), int64(0), gomock.Any()).
+		Return(weather_service.CityWeatherConditions{berlinCondition, parisCondition}, nil).
+		Times(1)
+
+	client := newClient(t, storage, subscription_service.NewService(4), make(chan struct{}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.Subscribe(ctx, &weather_collector_events.SubscribeRequest{})
+	require.NoError(t, err)
+
+	for _, want := range []string{"Berlin", "Paris"} {
+		condition, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, want, condition.GetCity().GetName())
+	}
+
+	t.Run("storage error", func(t *testing.T) {
+		storage := NewMockStorage(ctrl)
+		storage.EXPECT().
+			GetCityCondition(gomock.Any(), int64(1)).
+			Return(weather_service.CityWeatherCondition{}, false, errors.New("connection refused")).
+			Times(1)
+
+		client := newClient(t, storage, subscription_service.NewService(4), make(chan struct{}))
+		stream, err := client.Subscribe(ctx, &weather_collector_events.SubscribeRequest{CityIds: []int64{1}})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
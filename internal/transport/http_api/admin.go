@@ -0,0 +1,193 @@
+package http_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/city_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"go.opentelemetry.io/otel"
+)
+
+//go:generate mockgen -source admin.go -destination admin_mocks_test.go -package http_api_test -typed
+
+// maxRequestBodyBytes bounds the JSON bodies the admin API reads.
+const maxRequestBodyBytes = 1 << 20
+
+type CityManager interface {
+	Cities(ctx context.Context) (city_service.Cities, error)
+	CreateCity(ctx context.Context, city city_service.City) (city_service.City, error)
+	UpdateCity(ctx context.Context, city city_service.City) (city_service.City, error)
+	DisableCity(ctx context.Context, id int64) (city_service.City, error)
+	DeleteCity(ctx context.Context, id int64) error
+}
+
+type AdminHandler struct {
+	cities CityManager
+	mux    *http.ServeMux
+}
+
+// NewAdminHandler serves the city management API:
+//
+//	GET    /v1/admin/cities                every city, disabled ones included
+//	POST   /v1/admin/cities                create a city
+//	PUT    /v1/admin/cities/{id}           replace a city
+//	POST   /v1/admin/cities/{id}/disable   stop collecting a city
+//	DELETE /v1/admin/cities/{id}           delete a city
+//
+// It has no authentication of its own and is meant to be served on an
+// address that is not exposed publicly.
+func NewAdminHandler(cities CityManager) *AdminHandler {
+	h := &AdminHandler{
+		cities: cities,
+		mux:    http.NewServeMux(),
+	}
+
+	h.mux.HandleFunc("GET /v1/admin/cities", h.listCities)
+	h.mux.HandleFunc("POST /v1/admin/cities", h.createCity)
+	h.mux.HandleFunc("PUT /v1/admin/cities/{id}", h.updateCity)
+	h.mux.HandleFunc("POST /v1/admin/cities/{id}/disable", h.disableCity)
+	h.mux.HandleFunc("DELETE /v1/admin/cities/{id}", h.deleteCity)
+
+	return h
+}
+
+func (h *AdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *AdminHandler) listCities(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer("").Start(r.Context(), fmt.Sprintf("[%T.listCities]", h))
+	defer span.End()
+
+	cities, err := h.cities.Cities(ctx)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	writeJSON(w, r, CitiesResponse{Cities: mapCities(cities)})
+}
+
+func (h *AdminHandler) createCity(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer("").Start(r.Context(), fmt.Sprintf("[%T.createCity]", h))
+	defer span.End()
+
+	city, err := cityBody(w, r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	created, err := h.cities.CreateCity(ctx, city)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	writeJSONStatus(w, http.StatusCreated, CityResponse{City: mapCity(created)})
+}
+
+func (h *AdminHandler) updateCity(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer("").Start(r.Context(), fmt.Sprintf("[%T.updateCity]", h))
+	defer span.End()
+
+	id, err := cityID(r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	city, err := cityBody(w, r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+	city.ID = id
+
+	updated, err := h.cities.UpdateCity(ctx, city)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	writeJSONStatus(w, http.StatusOK, CityResponse{City: mapCity(updated)})
+}
+
+func (h *AdminHandler) disableCity(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer("").Start(r.Context(), fmt.Sprintf("[%T.disableCity]", h))
+	defer span.End()
+
+	id, err := cityID(r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	disabled, err := h.cities.DisableCity(ctx, id)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	writeJSONStatus(w, http.StatusOK, CityResponse{City: mapCity(disabled)})
+}
+
+func (h *AdminHandler) deleteCity(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer("").Start(r.Context(), fmt.Sprintf("[%T.deleteCity]", h))
+	defer span.End()
+
+	id, err := cityID(r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	if err := h.cities.DeleteCity(ctx, id); err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func cityID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id < 1 {
+		return 0, fmt.Errorf("%w: invalid city id", errBadRequest)
+	}
+
+	return id, nil
+}
+
+// cityBody reads a CityRequest. A city is enabled unless the request says
+// otherwise.
+func cityBody(w http.ResponseWriter, r *http.Request) (city_service.City, error) {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes))
+	decoder.DisallowUnknownFields()
+
+	var request CityRequest
+	if err := decoder.Decode(&request); err != nil {
+		return city_service.City{}, fmt.Errorf("%w: invalid body: %w", errBadRequest, err)
+	}
+
+	enabled := true
+	if request.Enabled != nil {
+		enabled = *request.Enabled
+	}
+
+	return city_service.City{
+		Name:    request.Name,
+		Country: request.Country,
+		Coordinates: weather_service.Coordinates{
+			Lat:  request.Lat,
+			Long: request.Long,
+		},
+		Enabled: enabled,
+		Tags:    request.Tags,
+	}, nil
+}
@@ -0,0 +1,236 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin.go
+//
+// Generated by this command:
+//
+//	mockgen -source admin.go -destination admin_mocks_test.go -package http_api_test -typed
+//
+
+// Package http_api_test is a generated GoMock package.
+package http_api_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	city_service "github.com/meteogo/weather-collector-service/internal/services/city_service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCityManager is a mock of CityManager interface.
+type MockCityManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockCityManagerMockRecorder is the mock recorder for MockCityManager.
+type MockCityManagerMockRecorder struct {
+	mock *MockCityManager
+}
+
+// NewMockCityManager creates a new mock instance.
+func NewMockCityManager(ctrl *gomock.Controller) *MockCityManager {
+	mock := &MockCityManager{ctrl: ctrl}
+	mock.recorder = &MockCityManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityManager) EXPECT() *MockCityManagerMockRecorder {
+	return m.recorder
+}
+
+// Cities mocks base method.
+func (m *MockCityManager) Cities(ctx context.Context) (city_service.Cities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cities", ctx)
+	ret0, _ := ret[0].(city_service.Cities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cities indicates an expected call of Cities.
+func (mr *MockCityManagerMockRecorder) Cities(ctx any) *MockCityManagerCitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cities", reflect.TypeOf((*MockCityManager)(nil).Cities), ctx)
+	return &MockCityManagerCitiesCall{Call: call}
+}
+
+// MockCityManagerCitiesCall wrap *gomock.Call
+type MockCityManagerCitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCityManagerCitiesCall) Return(arg0 city_service.Cities, arg1 error) *MockCityManagerCitiesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCityManagerCitiesCall) Do(f func(context.Context) (city_service.Cities, error)) *MockCityManagerCitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCityManagerCitiesCall) DoAndReturn(f func(context.Context) (city_service.Cities, error)) *MockCityManagerCitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateCity mocks base method.
+func (m *MockCityManager) CreateCity(ctx context.Context, city city_service.City) (city_service.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", ctx, city)
+	ret0, _ := ret[0].(city_service.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockCityManagerMockRecorder) CreateCity(ctx, city any) *MockCityManagerCreateCityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockCityManager)(nil).CreateCity), ctx, city)
+	return &MockCityManagerCreateCityCall{Call: call}
+}
+
+// MockCityManagerCreateCityCall wrap *gomock.Call
+type MockCityManagerCreateCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCityManagerCreateCityCall) Return(arg0 city_service.City, arg1 error) *MockCityManagerCreateCityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCityManagerCreateCityCall) Do(f func(context.Context, city_service.City) (city_service.City, error)) *MockCityManagerCreateCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCityManagerCreateCityCall) DoAndReturn(f func(context.Context, city_service.City) (city_service.City, error)) *MockCityManagerCreateCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCity mocks base method.
+func (m *MockCityManager) DeleteCity(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCity", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCity indicates an expected call of DeleteCity.
+func (mr *MockCityManagerMockRecorder) DeleteCity(ctx, id any) *MockCityManagerDeleteCityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCity", reflect.TypeOf((*MockCityManager)(nil).DeleteCity), ctx, id)
+	return &MockCityManagerDeleteCityCall{Call: call}
+}
+
+// MockCityManagerDeleteCityCall wrap *gomock.Call
+type MockCityManagerDeleteCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCityManagerDeleteCityCall) Return(arg0 error) *MockCityManagerDeleteCityCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCityManagerDeleteCityCall) Do(f func(context.Context, int64) error) *MockCityManagerDeleteCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCityManagerDeleteCityCall) DoAndReturn(f func(context.Context, int64) error) *MockCityManagerDeleteCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DisableCity mocks base method.
+func (m *MockCityManager) DisableCity(ctx context.Context, id int64) (city_service.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableCity", ctx, id)
+	ret0, _ := ret[0].(city_service.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableCity indicates an expected call of DisableCity.
+func (mr *MockCityManagerMockRecorder) DisableCity(ctx, id any) *MockCityManagerDisableCityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableCity", reflect.TypeOf((*MockCityManager)(nil).DisableCity), ctx, id)
+	return &MockCityManagerDisableCityCall{Call: call}
+}
+
+// MockCityManagerDisableCityCall wrap *gomock.Call
+type MockCityManagerDisableCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCityManagerDisableCityCall) Return(arg0 city_service.City, arg1 error) *MockCityManagerDisableCityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCityManagerDisableCityCall) Do(f func(context.Context, int64) (city_service.City, error)) *MockCityManagerDisableCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCityManagerDisableCityCall) DoAndReturn(f func(context.Context, int64) (city_service.City, error)) *MockCityManagerDisableCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateCity mocks base method.
+func (m *MockCityManager) UpdateCity(ctx context.Context, city city_service.City) (city_service.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", ctx, city)
+	ret0, _ := ret[0].(city_service.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockCityManagerMockRecorder) UpdateCity(ctx, city any) *MockCityManagerUpdateCityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockCityManager)(nil).UpdateCity), ctx, city)
+	return &MockCityManagerUpdateCityCall{Call: call}
+}
+
+// MockCityManagerUpdateCityCall wrap *gomock.Call
+type MockCityManagerUpdateCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCityManagerUpdateCityCall) Return(arg0 city_service.City, arg1 error) *MockCityManagerUpdateCityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCityManagerUpdateCityCall) Do(f func(context.Context, city_service.City) (city_service.City, error)) *MockCityManagerUpdateCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCityManagerUpdateCityCall) DoAndReturn(f func(context.Context, city_service.City) (city_service.City, error)) *MockCityManagerUpdateCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,253 @@
+package http_api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/services/city_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/meteogo/weather-collector-service/internal/transport/http_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var berlinCity = city_service.City{
+	ID:          1,
+	Name:        "Berlin",
+	Country:     "DE",
+	Coordinates: weather_service.Coordinates{Lat: 52.52, Long: 13.41},
+	Enabled:     true,
+	Tags:        []string{"capital"},
+	CreatedAt:   capturedAt,
+	UpdatedAt:   capturedAt,
+}
+
+func TestAdminHandler(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	disabledBerlin := berlinCity
+	disabledBerlin.Enabled = false
+
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		cities         func(ctrl *gomock.Controller) http_api.CityManager
+		wantStatusCode int
+		wantCity       *http_api.City
+	}{
+		{
+			name:   "list",
+			method: http.MethodGet,
+			target: "/v1/admin/cities",
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				mock := NewMockCityManager(ctrl)
+				mock.EXPECT().
+					Cities(gomock.Any()).
+					Return(city_service.Cities{berlinCity}, nil).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "create enables by default",
+			method: http.MethodPost,
+			target: "/v1/admin/cities",
+			body:   `{"name": "Berlin", "country": "DE", "lat": 52.52, "long": 13.41, "tags": ["capital"]}`,
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				mock := NewMockCityManager(ctrl)
+				mock.EXPECT().
+					CreateCity(gomock.Any(), city_service.City{
+						Name:        "Berlin",
+						Country:     "DE",
+						Coordinates: berlinCity.Coordinates,
+						Enabled:     true,
+						Tags:        []string{"capital"},
+					}).
+					Return(berlinCity, nil).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusCreated,
+			wantCity: &http_api.City{
+				ID:        1,
+				Name:      "Berlin",
+				Country:   "DE",
+				Lat:       52.52,
+				Long:      13.41,
+				Enabled:   true,
+				Tags:      []string{"capital"},
+				CreatedAt: capturedAt,
+				UpdatedAt: capturedAt,
+			},
+		},
+		{
+			name:   "create with unknown field",
+			method: http.MethodPost,
+			target: "/v1/admin/cities",
+			body:   `{"name": "Berlin", "latitude": 52.52}`,
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				return NewMockCityManager(ctrl)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "create invalid city",
+			method: http.MethodPost,
+			target: "/v1/admin/cities",
+			body:   `{"name": "", "lat": 52.52, "long": 13.41}`,
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				mock := NewMockCityManager(ctrl)
+				mock.EXPECT().
+					CreateCity(gomock.Any(), gomock.Any()).
+					Return(city_service.City{}, city_service.ErrInvalidCity).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "create duplicate",
+			method: http.MethodPost,
+			target: "/v1/admin/cities",
+			body:   `{"name": "Berlin", "lat": 52.52, "long": 13.41}`,
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				mock := NewMockCityManager(ctrl)
+				mock.EXPECT().
+					CreateCity(gomock.Any(), gomock.Any()).
+					Return(city_service.City{}, city_service.ErrCityExists).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:   "update keeps the path id",
+			method: http.MethodPut,
+			target: "/v1/admin/cities/1",
+			body:   `{"name": "Berlin", "country": "DE", "lat": 52.52, "long": 13.41, "enabled": false, "tags": ["capital"]}`,
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				mock := NewMockCityManager(ctrl)
+				mock.EXPECT().
+					UpdateCity(gomock.Any(), city_service.City{
+						ID:          1,
+						Name:        "Berlin",
+						Country:     "DE",
+						Coordinates: berlinCity.Coordinates,
+						Enabled:     false,
+						Tags:        []string{"capital"},
+					}).
+					Return(disabledBerlin, nil).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "update unknown city",
+			method: http.MethodPut,
+			target: "/v1/admin/cities/42",
+			body:   `{"name": "Berlin", "lat": 52.52, "long": 13.41}`,
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				mock := NewMockCityManager(ctrl)
+				mock.EXPECT().
+					UpdateCity(gomock.Any(), gomock.Any()).
+					Return(city_service.City{}, city_service.ErrCityNotFound).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "disable",
+			method: http.MethodPost,
+			target: "/v1/admin/cities/1/disable",
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				mock := NewMockCityManager(ctrl)
+				mock.EXPECT().
+					DisableCity(gomock.Any(), int64(1)).
+					Return(disabledBerlin, nil).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			target: "/v1/admin/cities/1",
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				mock := NewMockCityManager(ctrl)
+				mock.EXPECT().
+					DeleteCity(gomock.Any(), int64(1)).
+					Return(nil).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name:   "delete with invalid id",
+			method: http.MethodDelete,
+			target: "/v1/admin/cities/berlin",
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				return NewMockCityManager(ctrl)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "storage error",
+			method: http.MethodDelete,
+			target: "/v1/admin/cities/1",
+			cities: func(ctrl *gomock.Controller) http_api.CityManager {
+				mock := NewMockCityManager(ctrl)
+				mock.EXPECT().
+					DeleteCity(gomock.Any(), int64(1)).
+					Return(errors.New("connection refused")).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			handler := http_api.NewAdminHandler(tt.cities(ctrl))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body)))
+
+			require.Equal(t, tt.wantStatusCode, rec.Code)
+			if tt.wantCity == nil {
+				return
+			}
+
+			var response http_api.CityResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, *tt.wantCity, response.City)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package http_api
+
+import (
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/city_service"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+)
+
+type (
+	// Condition is a reading in the unit system asked for by the client.
+	// Measurements the provider did not report are left out.
+	Condition struct {
+		CityID                  int64     `json:"cityId"`
+		City                    string    `json:"city"`
+		Lat                     float64   `json:"lat"`
+		Long                    float64   `json:"long"`
+		CapturedAt              time.Time `json:"capturedAt"`
+		Temperature             *float64  `json:"temperature,omitempty"`
+		RelativeHumidityPercent *uint8    `json:"relativeHumidityPercent,omitempty"`
+		WindSpeed               *float64  `json:"windSpeed,omitempty"`
+		WeatherCode             *int32    `json:"weatherCode,omitempty"`
+		CloudCoverPercent       *uint8    `json:"cloudCoverPercent,omitempty"`
+		Precipitation           *float64  `json:"precipitation,omitempty"`
+		Visibility              *float64  `json:"visibility,omitempty"`
+		Provider                string    `json:"provider"`
+	}
+
+	Units struct {
+		Temperature   enums.Unit `json:"temperature"`
+		WindSpeed     enums.Unit `json:"windSpeed"`
+		Precipitation enums.Unit `json:"precipitation"`
+		Visibility    enums.Unit `json:"visibility"`
+	}
+
+	ConditionResponse struct {
+		Condition Condition `json:"condition"`
+		Units     Units     `json:"units"`
+	}
+
+	ConditionsResponse struct {
+		Conditions    []Condition `json:"conditions"`
+		Units         Units       `json:"units"`
+		NextPageToken string      `json:"nextPageToken,omitempty"`
+	}
+
+	ErrorResponse struct {
+		Error string `json:"error"`
+	}
+
+	CityRequest struct {
+		Name    string   `json:"name"`
+		Country string   `json:"country"`
+		Lat     float64  `json:"lat"`
+		Long    float64  `json:"long"`
+		Enabled *bool    `json:"enabled"`
+		Tags    []string `json:"tags"`
+	}
+
+	City struct {
+		ID        int64     `json:"id"`
+		Name      string    `json:"name"`
+		Country   string    `json:"country"`
+		Lat       float64   `json:"lat"`
+		Long      float64   `json:"long"`
+		Enabled   bool      `json:"enabled"`
+		Tags      []string  `json:"tags"`
+		CreatedAt time.Time `json:"createdAt"`
+		UpdatedAt time.Time `json:"updatedAt"`
+	}
+
+	CityResponse struct {
+		City City `json:"city"`
+	}
+
+	CitiesResponse struct {
+		Cities []City `json:"cities"`
+	}
+)
+
+func mapCondition(c weather_service.CityWeatherCondition, units enums.UnitSystem) Condition {
+	return Condition{
+		CityID:                  c.City.ID,
+		City:                    c.City.Name,
+		Lat:                     c.City.Lat,
+		Long:                    c.City.Long,
+		CapturedAt:              c.CapturedAt.UTC(),
+		Temperature:             ptr.Map(c.Temperature, func(v enums.Temperature) float64 { return v.In(units.TemperatureUnit()) }),
+		RelativeHumidityPercent: c.RelativeHumidityPercent,
+		WindSpeed:               ptr.Map(c.WindSpeed, func(v enums.Speed) float64 { return v.In(units.WindSpeedUnit()) }),
+		WeatherCode:             ptr.Map(c.WeatherCode, func(v enums.WeatherCode) int32 { return int32(v) }),
+		CloudCoverPercent:       c.CloudCoverPercent,
+		Precipitation:           ptr.Map(c.Precipitation, func(v enums.Length) float64 { return v.In(units.PrecipitationUnit()) }),
+		Visibility:              ptr.Map(c.Visibility, func(v enums.Length) float64 { return v.In(units.VisibilityUnit()) }),
+		Provider:                string(c.Provider),
+	}
+}
+
+func mapConditions(conditions weather_service.CityWeatherConditions, units enums.UnitSystem) []Condition {
+	out := make([]Condition, 0, len(conditions))
+	for _, condition := range conditions {
+		out = append(out, mapCondition(condition, units))
+	}
+
+	return out
+}
+
+func mapUnits(units enums.UnitSystem) Units {
+	return Units{
+		Temperature:   units.TemperatureUnit(),
+		WindSpeed:     units.WindSpeedUnit(),
+		Precipitation: units.PrecipitationUnit(),
+		Visibility:    units.VisibilityUnit(),
+	}
+}
+
+func mapCity(c city_service.City) City {
+	tags := c.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return City{
+		ID:        c.ID,
+		Name:      c.Name,
+		Country:   c.Country,
+		Lat:       c.Lat,
+		Long:      c.Long,
+		Enabled:   c.Enabled,
+		Tags:      tags,
+		CreatedAt: c.CreatedAt.UTC(),
+		UpdatedAt: c.UpdatedAt.UTC(),
+	}
+}
+
+func mapCities(cities city_service.Cities) []City {
+	out := make([]City, 0, len(cities))
+	for _, city := range cities {
+		out = append(out, mapCity(city))
+	}
+
+	return out
+}
@@ -0,0 +1,275 @@
+package http_api
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/telemetry"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"go.opentelemetry.io/otel"
+)
+
+//go:generate mockgen -source handler.go -destination handler_mocks_test.go -package http_api_test -typed
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+
+	// defaultHistoryRange is served when the history request names no range.
+	defaultHistoryRange = 24 * time.Hour
+
+	// observationPrecision is the precision of the captured_at column, the
+	// next history page starts this much after the last returned reading.
+	observationPrecision = time.Microsecond
+)
+
+type Storage interface {
+	GetConditionsPage(ctx context.Context, afterCityID int64, limit int) (weather_service.CityWeatherConditions, error)
+	GetCityCondition(ctx context.Context, cityID int64) (weather_service.CityWeatherCondition, bool, error)
+	GetConditionsHistory(ctx context.Context, cityID int64, from, to time.Time, limit int) (weather_service.CityWeatherConditions, error)
+}
+
+// errBadRequest marks errors caused by the query of the client.
+var errBadRequest = errors.New("bad request")
+
+type Handler struct {
+	storage Storage
+	mux     *http.ServeMux
+}
+
+// NewHandler serves the read API:
+//
+//	GET /v1/conditions                current conditions of all cities
+//	GET /v1/conditions/{id}           current condition of a city
+//	GET /v1/conditions/{id}/history   readings of a city within from and to
+//
+// Cities are addressed by id, names are only unique within a country.
+// Every route accepts units, "metric" by default, "imperial" or a JSON
+// object as in kafka_weather_unit_system. Lists are paginated with limit and
+// the pageToken returned as nextPageToken.
+func NewHandler(storage Storage) *Handler {
+	h := &Handler{
+		storage: storage,
+		mux:     http.NewServeMux(),
+	}
+
+	h.mux.HandleFunc("GET /v1/conditions", h.listConditions)
+	h.mux.HandleFunc("GET /v1/conditions/{id}", h.getCondition)
+	h.mux.HandleFunc("GET /v1/conditions/{id}/history", h.getHistory)
+
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) listConditions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer("").Start(r.Context(), fmt.Sprintf("[%T.listConditions]", h))
+	defer span.End()
+
+	units, limit, after, err := listQuery(r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	var afterCityID int64
+	if after != "" {
+		if afterCityID, err = strconv.ParseInt(after, 10, 64); err != nil {
+			writeError(w, r, telemetry.Fail(span, fmt.Errorf("%w: invalid pageToken", errBadRequest)))
+			return
+		}
+	}
+
+	conditions, err := h.storage.GetConditionsPage(ctx, afterCityID, limit+1)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	response := ConditionsResponse{
+		Units: mapUnits(units),
+	}
+
+	if len(conditions) > limit {
+		conditions = conditions[:limit]
+		response.NextPageToken = encodePageToken(strconv.FormatInt(conditions[limit-1].City.ID, 10))
+	}
+
+	response.Conditions = mapConditions(conditions, units)
+	writeJSON(w, r, response)
+}
+
+func (h *Handler) getCondition(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer("").Start(r.Context(), fmt.Sprintf("[%T.getCondition]", h))
+	defer span.End()
+
+	id, err := cityID(r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+	span.SetAttributes(telemetry.CityIDKey.Int64(id))
+
+	units, err := unitsQuery(r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	condition, ok, err := h.storage.GetCityCondition(ctx, id)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	if !ok {
+		writeJSONStatus(w, http.StatusNotFound, ErrorResponse{Error: fmt.Sprintf("city %d has no conditions", id)})
+		return
+	}
+
+	writeJSON(w, r, ConditionResponse{
+		Condition: mapCondition(condition, units),
+		Units:     mapUnits(units),
+	})
+}
+
+func (h *Handler) getHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer("").Start(r.Context(), fmt.Sprintf("[%T.getHistory]", h))
+	defer span.End()
+
+	id, err := cityID(r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+	span.SetAttributes(telemetry.CityIDKey.Int64(id))
+
+	units, limit, after, err := listQuery(r)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	from, to, err := rangeQuery(r, time.Now())
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	if after != "" {
+		last, err := time.Parse(time.RFC3339Nano, after)
+		if err != nil {
+			writeError(w, r, telemetry.Fail(span, fmt.Errorf("%w: invalid pageToken", errBadRequest)))
+			return
+		}
+
+		if next := last.Add(observationPrecision); next.After(from) {
+			from = next
+		}
+	}
+
+	conditions, err := h.storage.GetConditionsHistory(ctx, id, from, to, limit+1)
+	if err != nil {
+		writeError(w, r, telemetry.Fail(span, err))
+		return
+	}
+
+	response := ConditionsResponse{
+		Units: mapUnits(units),
+	}
+
+	if len(conditions) > limit {
+		conditions = conditions[:limit]
+		response.NextPageToken = encodePageToken(conditions[limit-1].CapturedAt.UTC().Format(time.RFC3339Nano))
+	}
+
+	response.Conditions = mapConditions(conditions, units)
+	writeJSON(w, r, response)
+}
+
+func unitsQuery(r *http.Request) (enums.UnitSystem, error) {
+	value := r.URL.Query().Get("units")
+	if value == "" {
+		return enums.UnitSystemMetric, nil
+	}
+
+	units, err := enums.ParseUnitSystem(value)
+	if err != nil {
+		return enums.UnitSystem{}, fmt.Errorf("%w: invalid units: %w", errBadRequest, err)
+	}
+
+	return units, nil
+}
+
+// listQuery reads the units and the pagination of a list request. after is
+// the decoded page token, empty on the first page.
+func listQuery(r *http.Request) (units enums.UnitSystem, limit int, after string, err error) {
+	units, err = unitsQuery(r)
+	if err != nil {
+		return enums.UnitSystem{}, 0, "", err
+	}
+
+	limit = defaultPageSize
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxPageSize {
+			return enums.UnitSystem{}, 0, "", fmt.Errorf("%w: limit must be between 1 and %d", errBadRequest, maxPageSize)
+		}
+	}
+
+	if token := r.URL.Query().Get("pageToken"); token != "" {
+		after, err = decodePageToken(token)
+		if err != nil {
+			return enums.UnitSystem{}, 0, "", fmt.Errorf("%w: invalid pageToken", errBadRequest)
+		}
+	}
+
+	return units, limit, after, nil
+}
+
+// rangeQuery reads from and to as RFC 3339 times. The range defaults to the
+// last defaultHistoryRange before to, which defaults to now.
+func rangeQuery(r *http.Request, now time.Time) (from, to time.Time, err error) {
+	to = now
+	if value := r.URL.Query().Get("to"); value != "" {
+		if to, err = time.Parse(time.RFC3339, value); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid to: %w", errBadRequest, err)
+		}
+	}
+
+	from = to.Add(-defaultHistoryRange)
+	if value := r.URL.Query().Get("from"); value != "" {
+		if from, err = time.Parse(time.RFC3339, value); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid from: %w", errBadRequest, err)
+		}
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: from must be before to", errBadRequest)
+	}
+
+	return from.UTC(), to.UTC(), nil
+}
+
+// Page tokens are opaque to clients, they carry the sort key of the last
+// item of the previous page.
+func encodePageToken(after string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(after))
+}
+
+func decodePageToken(token string) (string, error) {
+	after, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", err
+	}
+
+	return string(after), nil
+}
@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source handler.go -destination handler_mocks_test.go -package http_api_test -typed
+//
+
+// Package http_api_test is a generated GoMock package.
+package http_api_test
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	weather_service "github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// GetCityCondition mocks base method.
+func (m *MockStorage) GetCityCondition(ctx context.Context, cityID int64) (weather_service.CityWeatherCondition, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCityCondition", ctx, cityID)
+	ret0, _ := ret[0].(weather_service.CityWeatherCondition)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCityCondition indicates an expected call of GetCityCondition.
+func (mr *MockStorageMockRecorder) GetCityCondition(ctx, cityID any) *MockStorageGetCityConditionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCityCondition", reflect.TypeOf((*MockStorage)(nil).GetCityCondition), ctx, cityID)
+	return &MockStorageGetCityConditionCall{Call: call}
+}
+
+// MockStorageGetCityConditionCall wrap *gomock.Call
+type MockStorageGetCityConditionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetCityConditionCall) Return(arg0 weather_service.CityWeatherCondition, arg1 bool, arg2 error) *MockStorageGetCityConditionCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetCityConditionCall) Do(f func(context.Context, int64) (weather_service.CityWeatherCondition, bool, error)) *MockStorageGetCityConditionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetCityConditionCall) DoAndReturn(f func(context.Context, int64) (weather_service.CityWeatherCondition, bool, error)) *MockStorageGetCityConditionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetConditionsHistory mocks base method.
+func (m *MockStorage) GetConditionsHistory(ctx context.Context, cityID int64, from, to time.Time, limit int) (weather_service.CityWeatherConditions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConditionsHistory", ctx, cityID, from, to, limit)
+	ret0, _ := ret[0].(weather_service.CityWeatherConditions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConditionsHistory indicates an expected call of GetConditionsHistory.
+func (mr *MockStorageMockRecorder) GetConditionsHistory(ctx, cityID, from, to, limit any) *MockStorageGetConditionsHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConditionsHistory", reflect.TypeOf((*MockStorage)(nil).GetConditionsHistory), ctx, cityID, from, to, limit)
+	return &MockStorageGetConditionsHistoryCall{Call: call}
+}
+
+// MockStorageGetConditionsHistoryCall wrap *gomock.Call
+type MockStorageGetConditionsHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetConditionsHistoryCall) Return(arg0 weather_service.CityWeatherConditions, arg1 error) *MockStorageGetConditionsHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetConditionsHistoryCall) Do(f func(context.Context, int64, time.Time, time.Time, int) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetConditionsHistoryCall) DoAndReturn(f func(context.Context, int64, time.Time, time.Time, int) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetConditionsPage mocks base method.
+func (m *MockStorage) GetConditionsPage(ctx context.Context, afterCityID int64, limit int) (weather_service.CityWeatherConditions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConditionsPage", ctx, afterCityID, limit)
+	ret0, _ := ret[0].(weather_service.CityWeatherConditions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConditionsPage indicates an expected call of GetConditionsPage.
+func (mr *MockStorageMockRecorder) GetConditionsPage(ctx, afterCityID, limit any) *MockStorageGetConditionsPageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConditionsPage", reflect.TypeOf((*MockStorage)(nil).GetConditionsPage), ctx, afterCityID, limit)
+	return &MockStorageGetConditionsPageCall{Call: call}
+}
+
+// MockStorageGetConditionsPageCall wrap *gomock.Call
+type MockStorageGetConditionsPageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageGetConditionsPageCall) Return(arg0 weather_service.CityWeatherConditions, arg1 error) *MockStorageGetConditionsPageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageGetConditionsPageCall) Do(f func(context.Context, int64, int) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsPageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageGetConditionsPageCall) DoAndReturn(f func(context.Context, int64, int) (weather_service.CityWeatherConditions, error)) *MockStorageGetConditionsPageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
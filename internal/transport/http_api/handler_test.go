@@ -0,0 +1,323 @@
+package http_api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/pkg/enums"
+	"github.com/meteogo/weather-collector-service/internal/pkg/ptr"
+	"github.com/meteogo/weather-collector-service/internal/services/weather_service"
+	"github.com/meteogo/weather-collector-service/internal/transport/http_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	capturedAt = time.Date(2025, 7, 10, 12, 0, 0, 0, time.UTC)
+
+	berlinCondition = weather_service.CityWeatherCondition{
+		City: weather_service.City{
+			ID:          1,
+			Name:        "Berlin",
+			Coordinates: weather_service.Coordinates{Lat: 52.52, Long: 13.41},
+		},
+		CapturedAt:  capturedAt,
+		Temperature: ptr.To(enums.Celsius(20)),
+		WindSpeed:   ptr.To(enums.KilometersPerHour(16.09344)),
+		Provider:    enums.ProviderOpenMeteo,
+	}
+
+	parisCondition = weather_service.CityWeatherCondition{
+		City: weather_service.City{
+			ID:          2,
+			Name:        "Paris",
+			Coordinates: weather_service.Coordinates{Lat: 48.86, Long: 2.35},
+		},
+		CapturedAt: capturedAt,
+		Provider:   enums.ProviderMetNorway,
+	}
+)
+
+func TestHandler_ListConditions(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	tests := []struct {
+		name           string
+		query          url.Values
+		storage        func(ctrl *gomock.Controller) http_api.Storage
+		wantStatusCode int
+		wantCities     []string
+		wantNextPage   bool
+	}{
+		{
+			name:  "first page",
+			query: url.Values{"limit": {"1"}},
+			storage: func(ctrl *gomock.Controller) http_api.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetConditionsPage(gomock.Any(), int64(0), 2).
+					Return(weather_service.CityWeatherConditions{berlinCondition, parisCondition}, nil).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusOK,
+			wantCities:     []string{"Berlin"},
+			wantNextPage:   true,
+		},
+		{
+			name:  "last page",
+			query: url.Values{"limit": {"1"}, "pageToken": {"MQ"}},
+			storage: func(ctrl *gomock.Controller) http_api.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetConditionsPage(gomock.Any(), int64(1), 2).
+					Return(weather_service.CityWeatherConditions{parisCondition}, nil).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusOK,
+			wantCities:     []string{"Paris"},
+		},
+		{
+			name:  "invalid limit",
+			query: url.Values{"limit": {"0"}},
+			storage: func(ctrl *gomock.Controller) http_api.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "invalid units",
+			query: url.Values{"units": {"nautical"}},
+			storage: func(ctrl *gomock.Controller) http_api.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "storage error",
+			storage: func(ctrl *gomock.Controller) http_api.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetConditionsPage(gomock.Any(), int64(0), 51).
+					Return(nil, errors.New("connection refused")).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			handler := http_api.NewHandler(tt.storage(ctrl))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/conditions?"+tt.query.Encode(), nil))
+
+			require.Equal(t, tt.wantStatusCode, rec.Code)
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+
+			var response http_api.ConditionsResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+			cities := make([]string, 0, len(response.Conditions))
+			for _, condition := range response.Conditions {
+				cities = append(cities, condition.City)
+			}
+
+			assert.Equal(t, tt.wantCities, cities)
+			assert.Equal(t, tt.wantNextPage, response.NextPageToken != "")
+		})
+	}
+}
+
+func TestHandler_GetCondition(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	ctrl := gomock.NewController(t)
+	storage := NewMockStorage(ctrl)
+	storage.EXPECT().
+		GetCityCondition(gomock.Any(), int64(1)).
+		Return(berlinCondition, true, nil).
+		AnyTimes()
+	storage.EXPECT().
+		GetCityCondition(gomock.Any(), int64(4)).
+		Return(weather_service.CityWeatherCondition{}, false, nil).
+		Times(1)
+
+	handler := http_api.NewHandler(storage)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/conditions/1?units=imperial", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response http_api.ConditionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, int64(1), response.Condition.CityID)
+	assert.Equal(t, "Berlin", response.Condition.City)
+	assert.InDelta(t, 68, *response.Condition.Temperature, 1e-9)
+	assert.InDelta(t, 10, *response.Condition.WindSpeed, 1e-9)
+	assert.Nil(t, response.Condition.Precipitation)
+	assert.Equal(t, enums.UnitFahrenheit, response.Units.Temperature)
+	assert.Equal(t, enums.UnitMilesPerHour, response.Units.WindSpeed)
+
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	t.Run("matching etag", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/conditions/1?units=imperial", nil)
+		req.Header.Set("If-None-Match", `"other", W/`+etag)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.Bytes())
+	})
+
+	t.Run("etag of other units", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/conditions/1", nil)
+		req.Header.Set("If-None-Match", etag)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+	})
+
+	t.Run("unknown city", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/conditions/4", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("city addressed by name", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/conditions/Berlin", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestHandler_GetHistory(t *testing.T) {
+	t.Parallel()
+	logger.InitLogger(logger.EnvTypeTesting, slog.LevelDebug)
+
+	var (
+		from   = capturedAt
+		to     = capturedAt.Add(2 * time.Hour)
+		second = berlinCondition
+	)
+	second.CapturedAt = capturedAt.Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		query          url.Values
+		storage        func(ctrl *gomock.Controller) http_api.Storage
+		wantStatusCode int
+		wantCapturedAt []time.Time
+		wantNextPage   bool
+	}{
+		{
+			name:  "first page",
+			query: url.Values{"from": {from.Format(time.RFC3339)}, "to": {to.Format(time.RFC3339)}, "limit": {"1"}},
+			storage: func(ctrl *gomock.Controller) http_api.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetConditionsHistory(gomock.Any(), int64(1), from, to, 2).
+					Return(weather_service.CityWeatherConditions{berlinCondition, second}, nil).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusOK,
+			wantCapturedAt: []time.Time{capturedAt},
+			wantNextPage:   true,
+		},
+		{
+			name: "next page starts after the last reading",
+			query: url.Values{
+				"from":      {from.Format(time.RFC3339)},
+				"to":        {to.Format(time.RFC3339)},
+				"limit":     {"1"},
+				"pageToken": {"MjAyNS0wNy0xMFQxMjowMDowMFo"},
+			},
+			storage: func(ctrl *gomock.Controller) http_api.Storage {
+				mock := NewMockStorage(ctrl)
+				mock.EXPECT().
+					GetConditionsHistory(gomock.Any(), int64(1), from.Add(time.Microsecond), to, 2).
+					Return(weather_service.CityWeatherConditions{second}, nil).
+					Times(1)
+
+				return mock
+			},
+			wantStatusCode: http.StatusOK,
+			wantCapturedAt: []time.Time{second.CapturedAt},
+		},
+		{
+			name:  "from after to",
+			query: url.Values{"from": {to.Format(time.RFC3339)}, "to": {from.Format(time.RFC3339)}},
+			storage: func(ctrl *gomock.Controller) http_api.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "invalid from",
+			query: url.Values{"from": {"yesterday"}},
+			storage: func(ctrl *gomock.Controller) http_api.Storage {
+				return NewMockStorage(ctrl)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			handler := http_api.NewHandler(tt.storage(ctrl))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/conditions/1/history?"+tt.query.Encode(), nil))
+
+			require.Equal(t, tt.wantStatusCode, rec.Code)
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+
+			var response http_api.ConditionsResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+			times := make([]time.Time, 0, len(response.Conditions))
+			for _, condition := range response.Conditions {
+				times = append(times, condition.CapturedAt)
+			}
+
+			assert.Equal(t, tt.wantCapturedAt, times)
+			assert.Equal(t, tt.wantNextPage, response.NextPageToken != "")
+		})
+	}
+}
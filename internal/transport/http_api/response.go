@@ -0,0 +1,77 @@
+package http_api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/meteogo/logger/pkg/logger"
+	"github.com/meteogo/weather-collector-service/internal/services/city_service"
+)
+
+// writeJSON answers with the body and a strong ETag derived from it. A
+// request whose If-None-Match names that ETag gets 304 without a body.
+func writeJSON(w http.ResponseWriter, r *http.Request, body any) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		logger.Error(r.Context(), "unable to write response", slog.Any("error", err))
+	}
+}
+
+// etagMatches follows the weak comparison RFC 9110 prescribes for
+// If-None-Match: a W/ prefix is ignored and "*" matches any ETag.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, errBadRequest), errors.Is(err, city_service.ErrInvalidCity):
+		writeJSONStatus(w, http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	case errors.Is(err, city_service.ErrCityNotFound):
+		writeJSONStatus(w, http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		return
+	case errors.Is(err, city_service.ErrCityExists):
+		writeJSONStatus(w, http.StatusConflict, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	logger.Error(r.Context(), "unable to serve request", slog.String("path", r.URL.Path), slog.Any("error", err))
+	writeJSONStatus(w, http.StatusInternalServerError, ErrorResponse{Error: http.StatusText(http.StatusInternalServerError)})
+}
+
+func writeJSONStatus(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,42 @@
+package http_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/meteogo/logger/pkg/logger"
+)
+
+const readHeaderTimeout = 5 * time.Second
+
+type Server struct {
+	server *http.Server
+}
+
+func NewServer(addr string, handler http.Handler) *Server {
+	return &Server{
+		server: &http.Server{
+			Addr:              addr,
+			Handler:           handler,
+			ReadHeaderTimeout: readHeaderTimeout,
+		},
+	}
+}
+
+func (s *Server) Start(ctx context.Context) {
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(ctx, "http api server stopped", slog.Any("error", err))
+		}
+	}()
+
+	logger.Info(ctx, fmt.Sprintf("[%T.Start] http api server started", s), slog.String("addr", s.server.Addr))
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE weather_forecasts (
+    city_name                 VARCHAR(255)        NOT NULL PRIMARY KEY,
+    latitude                  DOUBLE PRECISION    NOT NULL,
+    longitude                 DOUBLE PRECISION    NOT NULL,
+    captured_at               TIMESTAMP           NOT NULL
+);
+
+CREATE TABLE hourly_weather_forecasts (
+    city_name                 VARCHAR(255)        NOT NULL REFERENCES weather_forecasts (city_name) ON DELETE CASCADE,
+    forecast_time             TIMESTAMP           NOT NULL,
+    temperature               DOUBLE PRECISION    NOT NULL,
+    relative_humidity_percent SMALLINT            NOT NULL,
+    wind_speed                DOUBLE PRECISION    NOT NULL,
+    weather_code              INTEGER             NOT NULL,
+    cloud_cover_percent       SMALLINT            NOT NULL,
+    precipitation_millimeters DOUBLE PRECISION    NOT NULL,
+    visibility_millimeters    DOUBLE PRECISION    NOT NULL,
+    PRIMARY KEY (city_name, forecast_time)
+);
+
+CREATE TABLE daily_weather_forecasts (
+    city_name                     VARCHAR(255)        NOT NULL REFERENCES weather_forecasts (city_name) ON DELETE CASCADE,
+    forecast_date                 DATE                NOT NULL,
+    weather_code                  INTEGER             NOT NULL,
+    temperature_max               DOUBLE PRECISION    NOT NULL,
+    temperature_min               DOUBLE PRECISION    NOT NULL,
+    precipitation_sum_millimeters DOUBLE PRECISION    NOT NULL,
+    wind_speed_max                DOUBLE PRECISION    NOT NULL,
+    PRIMARY KEY (city_name, forecast_date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE daily_weather_forecasts;
+DROP TABLE hourly_weather_forecasts;
+DROP TABLE weather_forecasts;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE weather_conditions_history (
+    city_name                 VARCHAR(255)        NOT NULL,
+    latitude                  DOUBLE PRECISION    NOT NULL,
+    longitude                 DOUBLE PRECISION    NOT NULL,
+    captured_at               TIMESTAMP           NOT NULL,
+    temperature               DOUBLE PRECISION    NOT NULL,
+    relative_humidity_percent SMALLINT            NOT NULL,
+    wind_speed                DOUBLE PRECISION    NOT NULL,
+    weather_code              INTEGER             NOT NULL,
+    cloud_cover_percent       SMALLINT            NOT NULL,
+    precipitation_millimeters DOUBLE PRECISION    NOT NULL,
+    visibility_millimeters    DOUBLE PRECISION    NOT NULL,
+    PRIMARY KEY (city_name, captured_at)
+);
+
+CREATE TABLE backfill_progress (
+    city_name                 VARCHAR(255)        NOT NULL,
+    start_date                DATE                NOT NULL,
+    end_date                  DATE                NOT NULL,
+    next_date                 DATE                NOT NULL,
+    updated_at                TIMESTAMP           NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (city_name, start_date, end_date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE backfill_progress;
+DROP TABLE weather_conditions_history;
+-- +goose StatementEnd
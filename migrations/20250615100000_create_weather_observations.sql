@@ -0,0 +1,90 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE weather_observations (
+    city_name                 VARCHAR(255)        NOT NULL,
+    latitude                  DOUBLE PRECISION    NOT NULL,
+    longitude                 DOUBLE PRECISION    NOT NULL,
+    captured_at               TIMESTAMP           NOT NULL,
+    temperature               DOUBLE PRECISION    NOT NULL,
+    relative_humidity_percent SMALLINT            NOT NULL,
+    wind_speed                DOUBLE PRECISION    NOT NULL,
+    weather_code              INTEGER             NOT NULL,
+    cloud_cover_percent       SMALLINT            NOT NULL,
+    precipitation_millimeters DOUBLE PRECISION    NOT NULL,
+    visibility_millimeters    DOUBLE PRECISION    NOT NULL,
+    PRIMARY KEY (city_name, captured_at)
+) PARTITION BY RANGE (captured_at);
+
+CREATE FUNCTION ensure_weather_observations_partition(ts TIMESTAMP) RETURNS VOID AS $$
+DECLARE
+    month_start    DATE := date_trunc('month', ts)::DATE;
+    month_end      DATE := (date_trunc('month', ts) + INTERVAL '1 month')::DATE;
+    partition_name TEXT := format('weather_observations_%s', to_char(month_start, 'YYYY_MM'));
+BEGIN
+    EXECUTE format(
+        'CREATE TABLE IF NOT EXISTS %I PARTITION OF weather_observations FOR VALUES FROM (%L) TO (%L)',
+        partition_name,
+        month_start,
+        month_end
+    );
+END;
+$$ LANGUAGE plpgsql;
+
+SELECT ensure_weather_observations_partition(month)
+FROM (
+    SELECT DISTINCT date_trunc('month', captured_at) AS month FROM current_weather_conditions
+    UNION
+    SELECT DISTINCT date_trunc('month', captured_at) AS month FROM weather_conditions_history
+) AS months;
+
+INSERT INTO weather_observations
+SELECT * FROM weather_conditions_history
+ON CONFLICT (city_name, captured_at) DO NOTHING;
+
+INSERT INTO weather_observations
+SELECT * FROM current_weather_conditions
+ON CONFLICT (city_name, captured_at) DO NOTHING;
+
+DROP TABLE weather_conditions_history;
+DROP TABLE current_weather_conditions;
+
+CREATE VIEW current_weather_conditions AS
+SELECT DISTINCT ON (city_name) *
+FROM weather_observations
+ORDER BY city_name, captured_at DESC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW current_weather_conditions;
+
+CREATE TABLE current_weather_conditions (
+    city_name                 VARCHAR(255)        NOT NULL PRIMARY KEY,
+    latitude                  DOUBLE PRECISION    NOT NULL,
+    longitude                 DOUBLE PRECISION    NOT NULL,
+    captured_at               TIMESTAMP           NOT NULL,
+    temperature               DOUBLE PRECISION    NOT NULL,
+    relative_humidity_percent SMALLINT            NOT NULL,
+    wind_speed                DOUBLE PRECISION    NOT NULL,
+    weather_code              INTEGER             NOT NULL,
+    cloud_cover_percent       SMALLINT            NOT NULL,
+    precipitation_millimeters DOUBLE PRECISION    NOT NULL,
+    visibility_millimeters    DOUBLE PRECISION    NOT NULL
+);
+
+INSERT INTO current_weather_conditions
+SELECT DISTINCT ON (city_name) *
+FROM weather_observations
+ORDER BY city_name, captured_at DESC;
+
+CREATE TABLE weather_conditions_history (
+    LIKE current_weather_conditions INCLUDING DEFAULTS,
+    PRIMARY KEY (city_name, captured_at)
+);
+
+INSERT INTO weather_conditions_history
+SELECT * FROM weather_observations;
+
+DROP TABLE weather_observations;
+DROP FUNCTION ensure_weather_observations_partition(TIMESTAMP);
+-- +goose StatementEnd
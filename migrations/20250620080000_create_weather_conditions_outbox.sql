@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE weather_conditions_outbox (
+    id                        BIGSERIAL           NOT NULL PRIMARY KEY,
+    city_name                 VARCHAR(255)        NOT NULL,
+    captured_at               TIMESTAMP           NOT NULL,
+    created_at                TIMESTAMP           NOT NULL DEFAULT NOW(),
+    published_at              TIMESTAMP,
+    attempts                  INTEGER             NOT NULL DEFAULT 0,
+    last_error                TEXT,
+    FOREIGN KEY (city_name, captured_at) REFERENCES weather_observations (city_name, captured_at) ON DELETE CASCADE
+);
+
+CREATE INDEX weather_conditions_outbox_pending_idx ON weather_conditions_outbox (id) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE weather_conditions_outbox;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE weather_observations ADD COLUMN provider VARCHAR(64) NOT NULL DEFAULT 'open_meteo';
+
+CREATE OR REPLACE VIEW current_weather_conditions AS
+SELECT DISTINCT ON (city_name) *
+FROM weather_observations
+ORDER BY city_name, captured_at DESC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW current_weather_conditions;
+
+ALTER TABLE weather_observations DROP COLUMN provider;
+
+CREATE VIEW current_weather_conditions AS
+SELECT DISTINCT ON (city_name) *
+FROM weather_observations
+ORDER BY city_name, captured_at DESC;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE weather_observations
+    ALTER COLUMN temperature               DROP NOT NULL,
+    ALTER COLUMN relative_humidity_percent DROP NOT NULL,
+    ALTER COLUMN wind_speed                DROP NOT NULL,
+    ALTER COLUMN weather_code              DROP NOT NULL,
+    ALTER COLUMN cloud_cover_percent       DROP NOT NULL,
+    ALTER COLUMN precipitation_millimeters DROP NOT NULL,
+    ALTER COLUMN visibility_millimeters    DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE weather_observations SET
+    temperature               = COALESCE(temperature, 0),
+    relative_humidity_percent = COALESCE(relative_humidity_percent, 0),
+    wind_speed                = COALESCE(wind_speed, 0),
+    weather_code              = COALESCE(weather_code, 0),
+    cloud_cover_percent       = COALESCE(cloud_cover_percent, 0),
+    precipitation_millimeters = COALESCE(precipitation_millimeters, 0),
+    visibility_millimeters    = COALESCE(visibility_millimeters, 0)
+WHERE temperature IS NULL
+   OR relative_humidity_percent IS NULL
+   OR wind_speed IS NULL
+   OR weather_code IS NULL
+   OR cloud_cover_percent IS NULL
+   OR precipitation_millimeters IS NULL
+   OR visibility_millimeters IS NULL;
+
+ALTER TABLE weather_observations
+    ALTER COLUMN temperature               SET NOT NULL,
+    ALTER COLUMN relative_humidity_percent SET NOT NULL,
+    ALTER COLUMN wind_speed                SET NOT NULL,
+    ALTER COLUMN weather_code              SET NOT NULL,
+    ALTER COLUMN cloud_cover_percent       SET NOT NULL,
+    ALTER COLUMN precipitation_millimeters SET NOT NULL,
+    ALTER COLUMN visibility_millimeters    SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE published_condition_fingerprints (
+    city_name                 VARCHAR(255)        NOT NULL PRIMARY KEY,
+    fingerprint               VARCHAR(64)         NOT NULL,
+    published_at              TIMESTAMP           NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE published_condition_fingerprints;
+-- +goose StatementEnd
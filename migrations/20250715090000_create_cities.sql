@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cities (
+    id                        BIGSERIAL           PRIMARY KEY,
+    name                      VARCHAR(255)        NOT NULL UNIQUE,
+    country                   VARCHAR(255)        NOT NULL DEFAULT '',
+    latitude                  DOUBLE PRECISION    NOT NULL,
+    longitude                 DOUBLE PRECISION    NOT NULL,
+    enabled                   BOOLEAN             NOT NULL DEFAULT TRUE,
+    tags                      TEXT[]              NOT NULL DEFAULT '{}',
+    created_at                TIMESTAMP           NOT NULL DEFAULT NOW(),
+    updated_at                TIMESTAMP           NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE cities;
+-- +goose StatementEnd
@@ -0,0 +1,173 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Readings of cities missing from the table get a city, so no history is
+-- left without one. Cities read within the last day were still reported and
+-- stay enabled.
+INSERT INTO cities (name, latitude, longitude, enabled)
+SELECT DISTINCT ON (city_name)
+    city_name,
+    latitude,
+    longitude,
+    captured_at > (NOW() AT TIME ZONE 'UTC') - INTERVAL '1 day'
+FROM (
+    SELECT city_name, latitude, longitude, captured_at FROM weather_observations
+    UNION ALL
+    SELECT city_name, latitude, longitude, captured_at FROM weather_forecasts
+) AS readings
+WHERE city_name NOT IN (SELECT name FROM cities)
+ORDER BY city_name, captured_at DESC;
+
+ALTER TABLE cities DROP CONSTRAINT cities_name_key;
+ALTER TABLE cities ADD CONSTRAINT cities_name_country_key UNIQUE (name, country);
+
+DROP VIEW current_weather_conditions;
+
+ALTER TABLE weather_observations ADD COLUMN city_id BIGINT;
+UPDATE weather_observations w SET city_id = (SELECT MIN(c.id) FROM cities c WHERE c.name = w.city_name);
+ALTER TABLE weather_observations ALTER COLUMN city_id SET NOT NULL;
+
+ALTER TABLE weather_conditions_outbox ADD COLUMN city_id BIGINT;
+UPDATE weather_conditions_outbox o SET city_id = (SELECT MIN(c.id) FROM cities c WHERE c.name = o.city_name);
+ALTER TABLE weather_conditions_outbox ALTER COLUMN city_id SET NOT NULL;
+ALTER TABLE weather_conditions_outbox DROP CONSTRAINT weather_conditions_outbox_city_name_captured_at_fkey;
+ALTER TABLE weather_conditions_outbox DROP COLUMN city_name;
+
+ALTER TABLE weather_observations DROP CONSTRAINT weather_observations_pkey;
+ALTER TABLE weather_observations ADD PRIMARY KEY (city_id, captured_at);
+
+ALTER TABLE weather_conditions_outbox
+    ADD FOREIGN KEY (city_id, captured_at) REFERENCES weather_observations (city_id, captured_at) ON DELETE CASCADE;
+
+CREATE VIEW current_weather_conditions AS
+SELECT DISTINCT ON (city_id) *
+FROM weather_observations
+ORDER BY city_id, captured_at DESC;
+
+ALTER TABLE published_condition_fingerprints ADD COLUMN city_id BIGINT;
+UPDATE published_condition_fingerprints f SET city_id = (SELECT MIN(c.id) FROM cities c WHERE c.name = f.city_name);
+DELETE FROM published_condition_fingerprints WHERE city_id IS NULL;
+ALTER TABLE published_condition_fingerprints DROP COLUMN city_name;
+ALTER TABLE published_condition_fingerprints ALTER COLUMN city_id SET NOT NULL;
+ALTER TABLE published_condition_fingerprints ADD PRIMARY KEY (city_id);
+
+ALTER TABLE backfill_progress ADD COLUMN city_id BIGINT;
+UPDATE backfill_progress b SET city_id = (SELECT MIN(c.id) FROM cities c WHERE c.name = b.city_name);
+DELETE FROM backfill_progress WHERE city_id IS NULL;
+ALTER TABLE backfill_progress DROP COLUMN city_name;
+ALTER TABLE backfill_progress ALTER COLUMN city_id SET NOT NULL;
+ALTER TABLE backfill_progress ADD PRIMARY KEY (city_id, start_date, end_date);
+
+-- Forecasts are replaced on every run, the tables start empty.
+DROP TABLE daily_weather_forecasts;
+DROP TABLE hourly_weather_forecasts;
+DROP TABLE weather_forecasts;
+
+CREATE TABLE weather_forecasts (
+    city_id                   BIGINT              NOT NULL PRIMARY KEY,
+    city_name                 VARCHAR(255)        NOT NULL,
+    latitude                  DOUBLE PRECISION    NOT NULL,
+    longitude                 DOUBLE PRECISION    NOT NULL,
+    captured_at               TIMESTAMP           NOT NULL
+);
+
+CREATE TABLE hourly_weather_forecasts (
+    city_id                   BIGINT              NOT NULL REFERENCES weather_forecasts (city_id) ON DELETE CASCADE,
+    forecast_time             TIMESTAMP           NOT NULL,
+    temperature               DOUBLE PRECISION    NOT NULL,
+    relative_humidity_percent SMALLINT            NOT NULL,
+    wind_speed                DOUBLE PRECISION    NOT NULL,
+    weather_code              INTEGER             NOT NULL,
+    cloud_cover_percent       SMALLINT            NOT NULL,
+    precipitation_millimeters DOUBLE PRECISION    NOT NULL,
+    visibility_millimeters    DOUBLE PRECISION    NOT NULL,
+    PRIMARY KEY (city_id, forecast_time)
+);
+
+CREATE TABLE daily_weather_forecasts (
+    city_id                       BIGINT              NOT NULL REFERENCES weather_forecasts (city_id) ON DELETE CASCADE,
+    forecast_date                 DATE                NOT NULL,
+    weather_code                  INTEGER             NOT NULL,
+    temperature_max               DOUBLE PRECISION    NOT NULL,
+    temperature_min               DOUBLE PRECISION    NOT NULL,
+    precipitation_sum_millimeters DOUBLE PRECISION    NOT NULL,
+    wind_speed_max                DOUBLE PRECISION    NOT NULL,
+    PRIMARY KEY (city_id, forecast_date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE daily_weather_forecasts;
+DROP TABLE hourly_weather_forecasts;
+DROP TABLE weather_forecasts;
+
+CREATE TABLE weather_forecasts (
+    city_name                 VARCHAR(255)        NOT NULL PRIMARY KEY,
+    latitude                  DOUBLE PRECISION    NOT NULL,
+    longitude                 DOUBLE PRECISION    NOT NULL,
+    captured_at               TIMESTAMP           NOT NULL
+);
+
+CREATE TABLE hourly_weather_forecasts (
+    city_name                 VARCHAR(255)        NOT NULL REFERENCES weather_forecasts (city_name) ON DELETE CASCADE,
+    forecast_time             TIMESTAMP           NOT NULL,
+    temperature               DOUBLE PRECISION    NOT NULL,
+    relative_humidity_percent SMALLINT            NOT NULL,
+    wind_speed                DOUBLE PRECISION    NOT NULL,
+    weather_code              INTEGER             NOT NULL,
+    cloud_cover_percent       SMALLINT            NOT NULL,
+    precipitation_millimeters DOUBLE PRECISION    NOT NULL,
+    visibility_millimeters    DOUBLE PRECISION    NOT NULL,
+    PRIMARY KEY (city_name, forecast_time)
+);
+
+CREATE TABLE daily_weather_forecasts (
+    city_name                     VARCHAR(255)        NOT NULL REFERENCES weather_forecasts (city_name) ON DELETE CASCADE,
+    forecast_date                 DATE                NOT NULL,
+    weather_code                  INTEGER             NOT NULL,
+    temperature_max               DOUBLE PRECISION    NOT NULL,
+    temperature_min               DOUBLE PRECISION    NOT NULL,
+    precipitation_sum_millimeters DOUBLE PRECISION    NOT NULL,
+    wind_speed_max                DOUBLE PRECISION    NOT NULL,
+    PRIMARY KEY (city_name, forecast_date)
+);
+
+ALTER TABLE backfill_progress ADD COLUMN city_name VARCHAR(255);
+UPDATE backfill_progress b SET city_name = (SELECT c.name FROM cities c WHERE c.id = b.city_id);
+DELETE FROM backfill_progress WHERE city_name IS NULL;
+ALTER TABLE backfill_progress DROP COLUMN city_id;
+ALTER TABLE backfill_progress ALTER COLUMN city_name SET NOT NULL;
+ALTER TABLE backfill_progress ADD PRIMARY KEY (city_name, start_date, end_date);
+
+ALTER TABLE published_condition_fingerprints ADD COLUMN city_name VARCHAR(255);
+UPDATE published_condition_fingerprints f SET city_name = (SELECT c.name FROM cities c WHERE c.id = f.city_id);
+DELETE FROM published_condition_fingerprints WHERE city_name IS NULL;
+ALTER TABLE published_condition_fingerprints DROP COLUMN city_id;
+ALTER TABLE published_condition_fingerprints ALTER COLUMN city_name SET NOT NULL;
+ALTER TABLE published_condition_fingerprints ADD PRIMARY KEY (city_name);
+
+DROP VIEW current_weather_conditions;
+
+ALTER TABLE weather_conditions_outbox ADD COLUMN city_name VARCHAR(255);
+UPDATE weather_conditions_outbox o SET city_name = w.city_name
+FROM weather_observations w
+WHERE w.city_id = o.city_id AND w.captured_at = o.captured_at;
+ALTER TABLE weather_conditions_outbox ALTER COLUMN city_name SET NOT NULL;
+ALTER TABLE weather_conditions_outbox DROP CONSTRAINT weather_conditions_outbox_city_id_captured_at_fkey;
+ALTER TABLE weather_conditions_outbox DROP COLUMN city_id;
+
+ALTER TABLE weather_observations DROP CONSTRAINT weather_observations_pkey;
+ALTER TABLE weather_observations ADD PRIMARY KEY (city_name, captured_at);
+ALTER TABLE weather_observations DROP COLUMN city_id;
+
+ALTER TABLE weather_conditions_outbox
+    ADD FOREIGN KEY (city_name, captured_at) REFERENCES weather_observations (city_name, captured_at) ON DELETE CASCADE;
+
+CREATE VIEW current_weather_conditions AS
+SELECT DISTINCT ON (city_name) *
+FROM weather_observations
+ORDER BY city_name, captured_at DESC;
+
+ALTER TABLE cities DROP CONSTRAINT cities_name_country_key;
+ALTER TABLE cities ADD CONSTRAINT cities_name_key UNIQUE (name);
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE alert_rule_states (
+    rule_name                 VARCHAR(255)        NOT NULL,
+    city_id                   BIGINT              NOT NULL,
+    raised                    BOOLEAN             NOT NULL,
+    matches                   INTEGER             NOT NULL,
+    misses                    INTEGER             NOT NULL,
+    captured_at               TIMESTAMP           NOT NULL,
+    PRIMARY KEY (rule_name, city_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE alert_rule_states;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE weather_conditions_outbox ADD COLUMN parked_at TIMESTAMP;
+
+DROP INDEX weather_conditions_outbox_pending_idx;
+CREATE INDEX weather_conditions_outbox_pending_idx ON weather_conditions_outbox (id) WHERE published_at IS NULL AND parked_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX weather_conditions_outbox_pending_idx;
+CREATE INDEX weather_conditions_outbox_pending_idx ON weather_conditions_outbox (id) WHERE published_at IS NULL;
+
+ALTER TABLE weather_conditions_outbox DROP COLUMN parked_at;
+-- +goose StatementEnd
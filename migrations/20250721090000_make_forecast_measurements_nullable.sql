@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE hourly_weather_forecasts
+    ALTER COLUMN temperature               DROP NOT NULL,
+    ALTER COLUMN relative_humidity_percent DROP NOT NULL,
+    ALTER COLUMN wind_speed                DROP NOT NULL,
+    ALTER COLUMN weather_code              DROP NOT NULL,
+    ALTER COLUMN cloud_cover_percent       DROP NOT NULL,
+    ALTER COLUMN precipitation_millimeters DROP NOT NULL,
+    ALTER COLUMN visibility_millimeters    DROP NOT NULL;
+
+ALTER TABLE daily_weather_forecasts
+    ALTER COLUMN weather_code                  DROP NOT NULL,
+    ALTER COLUMN temperature_max               DROP NOT NULL,
+    ALTER COLUMN temperature_min               DROP NOT NULL,
+    ALTER COLUMN precipitation_sum_millimeters DROP NOT NULL,
+    ALTER COLUMN wind_speed_max                DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Forecasts are replaced on every run, rows with missing values are dropped
+-- instead of being filled with made up zeros.
+DELETE FROM hourly_weather_forecasts
+WHERE temperature IS NULL
+   OR relative_humidity_percent IS NULL
+   OR wind_speed IS NULL
+   OR weather_code IS NULL
+   OR cloud_cover_percent IS NULL
+   OR precipitation_millimeters IS NULL
+   OR visibility_millimeters IS NULL;
+
+DELETE FROM daily_weather_forecasts
+WHERE weather_code IS NULL
+   OR temperature_max IS NULL
+   OR temperature_min IS NULL
+   OR precipitation_sum_millimeters IS NULL
+   OR wind_speed_max IS NULL;
+
+ALTER TABLE hourly_weather_forecasts
+    ALTER COLUMN temperature               SET NOT NULL,
+    ALTER COLUMN relative_humidity_percent SET NOT NULL,
+    ALTER COLUMN wind_speed                SET NOT NULL,
+    ALTER COLUMN weather_code              SET NOT NULL,
+    ALTER COLUMN cloud_cover_percent       SET NOT NULL,
+    ALTER COLUMN precipitation_millimeters SET NOT NULL,
+    ALTER COLUMN visibility_millimeters    SET NOT NULL;
+
+ALTER TABLE daily_weather_forecasts
+    ALTER COLUMN weather_code                  SET NOT NULL,
+    ALTER COLUMN temperature_max               SET NOT NULL,
+    ALTER COLUMN temperature_min               SET NOT NULL,
+    ALTER COLUMN precipitation_sum_millimeters SET NOT NULL,
+    ALTER COLUMN wind_speed_max                SET NOT NULL;
+-- +goose StatementEnd
@@ -7,6 +7,7 @@
 package weather_collector_events
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -207,6 +208,9 @@ type City struct {
 
 	Name        string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Coordinates *Coordinates `protobuf:"bytes,2,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	// Id of the city in the collector. It stays the same when the city is
+	// renamed, per-city messages are keyed by it.
+	Id int64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *City) Reset() {
@@ -255,26 +259,130 @@ func (x *City) GetCoordinates() *Coordinates {
 	return nil
 }
 
+func (x *City) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// MeasurementUnits declares the unit system of a topic, e.g. "°C" or "°F" for
+// temperature, "km/h", "m/s", "mph" or "kn" for wind speed, "mm" or "inch" for
+// precipitation and "m" or "ft" for visibility.
+type MeasurementUnits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Temperature   string `protobuf:"bytes,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	WindSpeed     string `protobuf:"bytes,2,opt,name=wind_speed,json=windSpeed,proto3" json:"wind_speed,omitempty"`
+	Precipitation string `protobuf:"bytes,3,opt,name=precipitation,proto3" json:"precipitation,omitempty"`
+	Visibility    string `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+}
+
+func (x *MeasurementUnits) Reset() {
+	*x = MeasurementUnits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_current_weather_conditions_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeasurementUnits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasurementUnits) ProtoMessage() {}
+
+func (x *MeasurementUnits) ProtoReflect() protoreflect.Message {
+	mi := &file_current_weather_conditions_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasurementUnits.ProtoReflect.Descriptor instead.
+func (*MeasurementUnits) Descriptor() ([]byte, []int) {
+	return file_current_weather_conditions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MeasurementUnits) GetTemperature() string {
+	if x != nil {
+		return x.Temperature
+	}
+	return ""
+}
+
+func (x *MeasurementUnits) GetWindSpeed() string {
+	if x != nil {
+		return x.WindSpeed
+	}
+	return ""
+}
+
+func (x *MeasurementUnits) GetPrecipitation() string {
+	if x != nil {
+		return x.Precipitation
+	}
+	return ""
+}
+
+func (x *MeasurementUnits) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// Measurements are optional: an unset field was not requested or not
+// reported by the provider, it is not a zero reading. Fields without a unit
+// in their name are expressed in units.
+//
+// Lengths are read from precipitation and visibility, in the unit system of
+// the topic. The millimetre fields are deprecated and still filled with the
+// same measurement for consumers that have not moved yet. They are removed,
+// and their numbers and names reserved, in the next major version of the
+// events.
 type CityWeatherCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	City                     *City                  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
-	CapturedAt               *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
-	Temperature              float64                `protobuf:"fixed64,3,opt,name=temperature,proto3" json:"temperature,omitempty"`
-	RelativeHumidityPercent  uint32                 `protobuf:"varint,4,opt,name=relative_humidity_percent,json=relativeHumidityPercent,proto3" json:"relative_humidity_percent,omitempty"`
-	WindSpeed                float64                `protobuf:"fixed64,5,opt,name=wind_speed,json=windSpeed,proto3" json:"wind_speed,omitempty"`
-	WeatherCode              WeatherCode            `protobuf:"varint,6,opt,name=weather_code,json=weatherCode,proto3,enum=weather_collector_events.WeatherCode" json:"weather_code,omitempty"`
-	CloudCoverPercent        uint32                 `protobuf:"varint,7,opt,name=cloud_cover_percent,json=cloudCoverPercent,proto3" json:"cloud_cover_percent,omitempty"`
-	PrecipitationMillimeters int64                  `protobuf:"varint,8,opt,name=precipitation_millimeters,json=precipitationMillimeters,proto3" json:"precipitation_millimeters,omitempty"`
-	VisibilityMillimeters    int64                  `protobuf:"varint,9,opt,name=visibility_millimeters,json=visibilityMillimeters,proto3" json:"visibility_millimeters,omitempty"`
+	City                    *City                  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	CapturedAt              *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
+	Temperature             *float64               `protobuf:"fixed64,3,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	RelativeHumidityPercent *uint32                `protobuf:"varint,4,opt,name=relative_humidity_percent,json=relativeHumidityPercent,proto3,oneof" json:"relative_humidity_percent,omitempty"`
+	WindSpeed               *float64               `protobuf:"fixed64,5,opt,name=wind_speed,json=windSpeed,proto3,oneof" json:"wind_speed,omitempty"`
+	WeatherCode             *WeatherCode           `protobuf:"varint,6,opt,name=weather_code,json=weatherCode,proto3,enum=weather_collector_events.WeatherCode,oneof" json:"weather_code,omitempty"`
+	CloudCoverPercent       *uint32                `protobuf:"varint,7,opt,name=cloud_cover_percent,json=cloudCoverPercent,proto3,oneof" json:"cloud_cover_percent,omitempty"`
+	// Deprecated: truncated to whole millimetres, use precipitation.
+	//
+	// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+	PrecipitationMillimeters *int64 `protobuf:"varint,8,opt,name=precipitation_millimeters,json=precipitationMillimeters,proto3,oneof" json:"precipitation_millimeters,omitempty"`
+	// Deprecated: use visibility.
+	//
+	// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+	VisibilityMillimeters *int64 `protobuf:"varint,9,opt,name=visibility_millimeters,json=visibilityMillimeters,proto3,oneof" json:"visibility_millimeters,omitempty"`
+	Provider              string `protobuf:"bytes,10,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Deprecated: use precipitation.
+	//
+	// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+	PrecipitationMm *float64          `protobuf:"fixed64,11,opt,name=precipitation_mm,json=precipitationMm,proto3,oneof" json:"precipitation_mm,omitempty"`
+	Units           *MeasurementUnits `protobuf:"bytes,12,opt,name=units,proto3" json:"units,omitempty"`
+	Precipitation   *float64          `protobuf:"fixed64,13,opt,name=precipitation,proto3,oneof" json:"precipitation,omitempty"`
+	Visibility      *float64          `protobuf:"fixed64,14,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
 }
 
 func (x *CityWeatherCondition) Reset() {
 	*x = CityWeatherCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_current_weather_conditions_proto_msgTypes[2]
+		mi := &file_current_weather_conditions_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +395,7 @@ func (x *CityWeatherCondition) String() string {
 func (*CityWeatherCondition) ProtoMessage() {}
 
 func (x *CityWeatherCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_current_weather_conditions_proto_msgTypes[2]
+	mi := &file_current_weather_conditions_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +408,7 @@ func (x *CityWeatherCondition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CityWeatherCondition.ProtoReflect.Descriptor instead.
 func (*CityWeatherCondition) Descriptor() ([]byte, []int) {
-	return file_current_weather_conditions_proto_rawDescGZIP(), []int{2}
+	return file_current_weather_conditions_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CityWeatherCondition) GetCity() *City {
@@ -318,50 +426,88 @@ func (x *CityWeatherCondition) GetCapturedAt() *timestamppb.Timestamp {
 }
 
 func (x *CityWeatherCondition) GetTemperature() float64 {
-	if x != nil {
-		return x.Temperature
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
 	}
 	return 0
 }
 
 func (x *CityWeatherCondition) GetRelativeHumidityPercent() uint32 {
-	if x != nil {
-		return x.RelativeHumidityPercent
+	if x != nil && x.RelativeHumidityPercent != nil {
+		return *x.RelativeHumidityPercent
 	}
 	return 0
 }
 
 func (x *CityWeatherCondition) GetWindSpeed() float64 {
-	if x != nil {
-		return x.WindSpeed
+	if x != nil && x.WindSpeed != nil {
+		return *x.WindSpeed
 	}
 	return 0
 }
 
 func (x *CityWeatherCondition) GetWeatherCode() WeatherCode {
-	if x != nil {
-		return x.WeatherCode
+	if x != nil && x.WeatherCode != nil {
+		return *x.WeatherCode
 	}
 	return WeatherCode_WEATHER_CODE_CLEAR_SKY
 }
 
 func (x *CityWeatherCondition) GetCloudCoverPercent() uint32 {
-	if x != nil {
-		return x.CloudCoverPercent
+	if x != nil && x.CloudCoverPercent != nil {
+		return *x.CloudCoverPercent
 	}
 	return 0
 }
 
+// Deprecated: Marked as deprecated in current_weather_conditions.proto.
 func (x *CityWeatherCondition) GetPrecipitationMillimeters() int64 {
-	if x != nil {
-		return x.PrecipitationMillimeters
+	if x != nil && x.PrecipitationMillimeters != nil {
+		return *x.PrecipitationMillimeters
 	}
 	return 0
 }
 
+// Deprecated: Marked as deprecated in current_weather_conditions.proto.
 func (x *CityWeatherCondition) GetVisibilityMillimeters() int64 {
+	if x != nil && x.VisibilityMillimeters != nil {
+		return *x.VisibilityMillimeters
+	}
+	return 0
+}
+
+func (x *CityWeatherCondition) GetProvider() string {
 	if x != nil {
-		return x.VisibilityMillimeters
+		return x.Provider
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+func (x *CityWeatherCondition) GetPrecipitationMm() float64 {
+	if x != nil && x.PrecipitationMm != nil {
+		return *x.PrecipitationMm
+	}
+	return 0
+}
+
+func (x *CityWeatherCondition) GetUnits() *MeasurementUnits {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *CityWeatherCondition) GetPrecipitation() float64 {
+	if x != nil && x.Precipitation != nil {
+		return *x.Precipitation
+	}
+	return 0
+}
+
+func (x *CityWeatherCondition) GetVisibility() float64 {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
 	}
 	return 0
 }
@@ -377,7 +523,7 @@ type CityWeatherConditions struct {
 func (x *CityWeatherConditions) Reset() {
 	*x = CityWeatherConditions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_current_weather_conditions_proto_msgTypes[3]
+		mi := &file_current_weather_conditions_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -390,7 +536,7 @@ func (x *CityWeatherConditions) String() string {
 func (*CityWeatherConditions) ProtoMessage() {}
 
 func (x *CityWeatherConditions) ProtoReflect() protoreflect.Message {
-	mi := &file_current_weather_conditions_proto_msgTypes[3]
+	mi := &file_current_weather_conditions_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +549,7 @@ func (x *CityWeatherConditions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CityWeatherConditions.ProtoReflect.Descriptor instead.
 func (*CityWeatherConditions) Descriptor() ([]byte, []int) {
-	return file_current_weather_conditions_proto_rawDescGZIP(), []int{3}
+	return file_current_weather_conditions_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CityWeatherConditions) GetConditions() []*CityWeatherCondition {
@@ -413,6 +559,384 @@ func (x *CityWeatherConditions) GetConditions() []*CityWeatherCondition {
 	return nil
 }
 
+// Measurements and lengths follow CityWeatherCondition: an unset field was
+// not requested or not reported, the millimetre fields are deprecated.
+type HourlyForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time                    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Temperature             *float64               `protobuf:"fixed64,2,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	RelativeHumidityPercent *uint32                `protobuf:"varint,3,opt,name=relative_humidity_percent,json=relativeHumidityPercent,proto3,oneof" json:"relative_humidity_percent,omitempty"`
+	WindSpeed               *float64               `protobuf:"fixed64,4,opt,name=wind_speed,json=windSpeed,proto3,oneof" json:"wind_speed,omitempty"`
+	WeatherCode             *WeatherCode           `protobuf:"varint,5,opt,name=weather_code,json=weatherCode,proto3,enum=weather_collector_events.WeatherCode,oneof" json:"weather_code,omitempty"`
+	CloudCoverPercent       *uint32                `protobuf:"varint,6,opt,name=cloud_cover_percent,json=cloudCoverPercent,proto3,oneof" json:"cloud_cover_percent,omitempty"`
+	// Deprecated: truncated to whole millimetres, use precipitation.
+	//
+	// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+	PrecipitationMillimeters *int64 `protobuf:"varint,7,opt,name=precipitation_millimeters,json=precipitationMillimeters,proto3,oneof" json:"precipitation_millimeters,omitempty"`
+	// Deprecated: use visibility.
+	//
+	// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+	VisibilityMillimeters *int64 `protobuf:"varint,8,opt,name=visibility_millimeters,json=visibilityMillimeters,proto3,oneof" json:"visibility_millimeters,omitempty"`
+	// Deprecated: use precipitation.
+	//
+	// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+	PrecipitationMm *float64 `protobuf:"fixed64,9,opt,name=precipitation_mm,json=precipitationMm,proto3,oneof" json:"precipitation_mm,omitempty"`
+	Precipitation   *float64 `protobuf:"fixed64,10,opt,name=precipitation,proto3,oneof" json:"precipitation,omitempty"`
+	Visibility      *float64 `protobuf:"fixed64,11,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
+}
+
+func (x *HourlyForecast) Reset() {
+	*x = HourlyForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_current_weather_conditions_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HourlyForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourlyForecast) ProtoMessage() {}
+
+func (x *HourlyForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_current_weather_conditions_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourlyForecast.ProtoReflect.Descriptor instead.
+func (*HourlyForecast) Descriptor() ([]byte, []int) {
+	return file_current_weather_conditions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HourlyForecast) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *HourlyForecast) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *HourlyForecast) GetRelativeHumidityPercent() uint32 {
+	if x != nil && x.RelativeHumidityPercent != nil {
+		return *x.RelativeHumidityPercent
+	}
+	return 0
+}
+
+func (x *HourlyForecast) GetWindSpeed() float64 {
+	if x != nil && x.WindSpeed != nil {
+		return *x.WindSpeed
+	}
+	return 0
+}
+
+func (x *HourlyForecast) GetWeatherCode() WeatherCode {
+	if x != nil && x.WeatherCode != nil {
+		return *x.WeatherCode
+	}
+	return WeatherCode_WEATHER_CODE_CLEAR_SKY
+}
+
+func (x *HourlyForecast) GetCloudCoverPercent() uint32 {
+	if x != nil && x.CloudCoverPercent != nil {
+		return *x.CloudCoverPercent
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+func (x *HourlyForecast) GetPrecipitationMillimeters() int64 {
+	if x != nil && x.PrecipitationMillimeters != nil {
+		return *x.PrecipitationMillimeters
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+func (x *HourlyForecast) GetVisibilityMillimeters() int64 {
+	if x != nil && x.VisibilityMillimeters != nil {
+		return *x.VisibilityMillimeters
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+func (x *HourlyForecast) GetPrecipitationMm() float64 {
+	if x != nil && x.PrecipitationMm != nil {
+		return *x.PrecipitationMm
+	}
+	return 0
+}
+
+func (x *HourlyForecast) GetPrecipitation() float64 {
+	if x != nil && x.Precipitation != nil {
+		return *x.Precipitation
+	}
+	return 0
+}
+
+func (x *HourlyForecast) GetVisibility() float64 {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return 0
+}
+
+type DailyForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	WeatherCode    *WeatherCode           `protobuf:"varint,2,opt,name=weather_code,json=weatherCode,proto3,enum=weather_collector_events.WeatherCode,oneof" json:"weather_code,omitempty"`
+	TemperatureMax *float64               `protobuf:"fixed64,3,opt,name=temperature_max,json=temperatureMax,proto3,oneof" json:"temperature_max,omitempty"`
+	TemperatureMin *float64               `protobuf:"fixed64,4,opt,name=temperature_min,json=temperatureMin,proto3,oneof" json:"temperature_min,omitempty"`
+	// Deprecated: truncated to whole millimetres, use precipitation_sum.
+	//
+	// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+	PrecipitationSumMillimeters *int64   `protobuf:"varint,5,opt,name=precipitation_sum_millimeters,json=precipitationSumMillimeters,proto3,oneof" json:"precipitation_sum_millimeters,omitempty"`
+	WindSpeedMax                *float64 `protobuf:"fixed64,6,opt,name=wind_speed_max,json=windSpeedMax,proto3,oneof" json:"wind_speed_max,omitempty"`
+	// Deprecated: use precipitation_sum.
+	//
+	// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+	PrecipitationSumMm *float64 `protobuf:"fixed64,7,opt,name=precipitation_sum_mm,json=precipitationSumMm,proto3,oneof" json:"precipitation_sum_mm,omitempty"`
+	PrecipitationSum   *float64 `protobuf:"fixed64,8,opt,name=precipitation_sum,json=precipitationSum,proto3,oneof" json:"precipitation_sum,omitempty"`
+}
+
+func (x *DailyForecast) Reset() {
+	*x = DailyForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_current_weather_conditions_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyForecast) ProtoMessage() {}
+
+func (x *DailyForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_current_weather_conditions_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyForecast.ProtoReflect.Descriptor instead.
+func (*DailyForecast) Descriptor() ([]byte, []int) {
+	return file_current_weather_conditions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DailyForecast) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *DailyForecast) GetWeatherCode() WeatherCode {
+	if x != nil && x.WeatherCode != nil {
+		return *x.WeatherCode
+	}
+	return WeatherCode_WEATHER_CODE_CLEAR_SKY
+}
+
+func (x *DailyForecast) GetTemperatureMax() float64 {
+	if x != nil && x.TemperatureMax != nil {
+		return *x.TemperatureMax
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetTemperatureMin() float64 {
+	if x != nil && x.TemperatureMin != nil {
+		return *x.TemperatureMin
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+func (x *DailyForecast) GetPrecipitationSumMillimeters() int64 {
+	if x != nil && x.PrecipitationSumMillimeters != nil {
+		return *x.PrecipitationSumMillimeters
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetWindSpeedMax() float64 {
+	if x != nil && x.WindSpeedMax != nil {
+		return *x.WindSpeedMax
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in current_weather_conditions.proto.
+func (x *DailyForecast) GetPrecipitationSumMm() float64 {
+	if x != nil && x.PrecipitationSumMm != nil {
+		return *x.PrecipitationSumMm
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetPrecipitationSum() float64 {
+	if x != nil && x.PrecipitationSum != nil {
+		return *x.PrecipitationSum
+	}
+	return 0
+}
+
+type CityWeatherForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City       *City                  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	CapturedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
+	Hourly     []*HourlyForecast      `protobuf:"bytes,3,rep,name=hourly,proto3" json:"hourly,omitempty"`
+	Daily      []*DailyForecast       `protobuf:"bytes,4,rep,name=daily,proto3" json:"daily,omitempty"`
+	Units      *MeasurementUnits      `protobuf:"bytes,5,opt,name=units,proto3" json:"units,omitempty"`
+}
+
+func (x *CityWeatherForecast) Reset() {
+	*x = CityWeatherForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_current_weather_conditions_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CityWeatherForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityWeatherForecast) ProtoMessage() {}
+
+func (x *CityWeatherForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_current_weather_conditions_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityWeatherForecast.ProtoReflect.Descriptor instead.
+func (*CityWeatherForecast) Descriptor() ([]byte, []int) {
+	return file_current_weather_conditions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CityWeatherForecast) GetCity() *City {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *CityWeatherForecast) GetCapturedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return nil
+}
+
+func (x *CityWeatherForecast) GetHourly() []*HourlyForecast {
+	if x != nil {
+		return x.Hourly
+	}
+	return nil
+}
+
+func (x *CityWeatherForecast) GetDaily() []*DailyForecast {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *CityWeatherForecast) GetUnits() *MeasurementUnits {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+type CityWeatherForecasts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forecasts []*CityWeatherForecast `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
+}
+
+func (x *CityWeatherForecasts) Reset() {
+	*x = CityWeatherForecasts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_current_weather_conditions_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CityWeatherForecasts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityWeatherForecasts) ProtoMessage() {}
+
+func (x *CityWeatherForecasts) ProtoReflect() protoreflect.Message {
+	mi := &file_current_weather_conditions_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityWeatherForecasts.ProtoReflect.Descriptor instead.
+func (*CityWeatherForecasts) Descriptor() ([]byte, []int) {
+	return file_current_weather_conditions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CityWeatherForecasts) GetForecasts() []*CityWeatherForecast {
+	if x != nil {
+		return x.Forecasts
+	}
+	return nil
+}
+
 var File_current_weather_conditions_proto protoreflect.FileDescriptor
 
 var file_current_weather_conditions_proto_rawDesc = []byte{
@@ -421,120 +945,304 @@ var file_current_weather_conditions_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x12, 0x18, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
 	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x1f, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a,
-	0x0b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03,
-	0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x6f,
-	0x6e, 0x67, 0x22, 0x63, 0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43,
-	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72,
-	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x22, 0xf2, 0x03, 0x0a, 0x14, 0x43, 0x69, 0x74, 0x79,
-	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x32, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x65, 0x0a, 0x0b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80,
+	0x56, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0xc0, 0x52, 0x03, 0x6c, 0x61, 0x74,
+	0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17,
+	0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x29, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0xc0, 0x52, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x22, 0x85, 0x01,
+	0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x22, 0xc9, 0x08, 0x0a, 0x14, 0x43, 0x69, 0x74, 0x79, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01,
+	0x02, 0x08, 0x01, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x25, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x19, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02,
+	0x18, 0x64, 0x48, 0x01, 0x52, 0x17, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x48, 0x75,
+	0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x32, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x48, 0x02, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x48, 0x03, 0x52, 0x0b, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a,
+	0x13, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
+	0x02, 0x18, 0x64, 0x48, 0x04, 0x52, 0x11, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x6f, 0x76, 0x65,
+	0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x19, 0x70,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02,
+	0x18, 0x01, 0x48, 0x05, 0x52, 0x18, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x45, 0x0a, 0x16, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x09, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x18, 0x01, 0x48, 0x06, 0x52, 0x15,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x10,
+	0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x01,
+	0x48, 0x07, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
+	0x73, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48,
+	0x08, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x1c,
+	0x0a, 0x1a, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x42, 0x19, 0x0a, 0x17,
+	0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6d, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x67, 0x0a,
+	0x15, 0x43, 0x69, 0x74, 0x79, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9a, 0x07, 0x0a, 0x0e, 0x48, 0x6f, 0x75, 0x72, 0x6c,
+	0x79, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x19, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x48, 0x01, 0x52, 0x17, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x48, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x02, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
 	0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x04,
-	0x63, 0x69, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x48, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x48,
-	0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x77, 0x65, 0x61,
-	0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x6f, 0x76, 0x65,
-	0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x70, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x16, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x48,
+	0x03, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x3c, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x48, 0x04, 0x52, 0x11, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x43, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x44, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x48, 0x05, 0x52, 0x18, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x16, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
 	0x69, 0x74, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x15,
-	0x43, 0x69, 0x74, 0x79, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x77, 0x65, 0x61, 0x74,
-	0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0xde, 0x07, 0x0a, 0x0b, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x5f, 0x53, 0x4b, 0x59, 0x10,
-	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x4c, 0x59, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10, 0x01,
-	0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x50, 0x41, 0x52, 0x54, 0x4c, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x59, 0x10, 0x02,
-	0x12, 0x19, 0x0a, 0x15, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x4f, 0x56, 0x45, 0x52, 0x43, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x57,
-	0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4f, 0x47, 0x10,
-	0x2d, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x49, 0x4d,
-	0x45, 0x5f, 0x46, 0x4f, 0x47, 0x10, 0x30, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x45, 0x41, 0x54, 0x48,
-	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x5a, 0x5a, 0x4c, 0x45, 0x5f,
-	0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x33, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x45, 0x41, 0x54, 0x48,
-	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x5a, 0x5a, 0x4c, 0x45, 0x5f,
-	0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x35, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x45,
-	0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x5a, 0x5a,
-	0x4c, 0x45, 0x5f, 0x44, 0x45, 0x4e, 0x53, 0x45, 0x10, 0x37, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x45,
-	0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5a,
-	0x49, 0x4e, 0x47, 0x5f, 0x44, 0x52, 0x49, 0x5a, 0x5a, 0x4c, 0x45, 0x5f, 0x4c, 0x49, 0x47, 0x48,
-	0x54, 0x10, 0x38, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5a, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x52, 0x49,
-	0x5a, 0x5a, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x4e, 0x53, 0x45, 0x10, 0x39, 0x12, 0x1c, 0x0a, 0x18,
-	0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x49,
-	0x4e, 0x5f, 0x53, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x3d, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x45,
-	0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f,
-	0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x3f, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x45,
-	0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f,
-	0x48, 0x45, 0x41, 0x56, 0x59, 0x10, 0x41, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x45, 0x41, 0x54, 0x48,
-	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5a, 0x49, 0x4e, 0x47,
-	0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x42, 0x12, 0x24, 0x0a,
-	0x20, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x52,
-	0x45, 0x45, 0x5a, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x56,
-	0x59, 0x10, 0x43, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x5f, 0x46, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x4c,
-	0x49, 0x47, 0x48, 0x54, 0x10, 0x47, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45,
-	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x5f, 0x46, 0x41, 0x4c, 0x4c,
-	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x49, 0x12, 0x20, 0x0a, 0x1c, 0x57,
-	0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57,
-	0x5f, 0x46, 0x41, 0x4c, 0x4c, 0x5f, 0x48, 0x45, 0x41, 0x56, 0x59, 0x10, 0x4b, 0x12, 0x1c, 0x0a,
-	0x18, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e,
-	0x4f, 0x57, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x53, 0x10, 0x4d, 0x12, 0x24, 0x0a, 0x20, 0x57,
-	0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x4e,
-	0x5f, 0x53, 0x48, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x5f, 0x53, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10,
-	0x50, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x51, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x45, 0x41,
-	0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x53,
-	0x48, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x45, 0x4e, 0x54, 0x10, 0x52,
-	0x12, 0x24, 0x0a, 0x20, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x5f, 0x53, 0x4c,
-	0x49, 0x47, 0x48, 0x54, 0x10, 0x55, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45,
-	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x5f, 0x53, 0x48, 0x4f, 0x57,
-	0x45, 0x52, 0x53, 0x5f, 0x48, 0x45, 0x41, 0x56, 0x59, 0x10, 0x56, 0x12, 0x24, 0x0a, 0x20, 0x57,
-	0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x48, 0x55, 0x4e,
-	0x44, 0x45, 0x52, 0x53, 0x54, 0x4f, 0x52, 0x4d, 0x5f, 0x53, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10,
-	0x5f, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x54, 0x48, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x53, 0x54, 0x4f, 0x52, 0x4d, 0x5f, 0x48,
-	0x41, 0x49, 0x4c, 0x5f, 0x53, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x60, 0x12, 0x28, 0x0a, 0x24,
-	0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x48, 0x55,
-	0x4e, 0x44, 0x45, 0x52, 0x53, 0x54, 0x4f, 0x52, 0x4d, 0x5f, 0x48, 0x41, 0x49, 0x4c, 0x5f, 0x48,
-	0x45, 0x41, 0x56, 0x59, 0x10, 0x63, 0x42, 0x2d, 0x5a, 0x2b, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x3b, 0x77, 0x65, 0x61,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x18, 0x01,
+	0x48, 0x06, 0x52, 0x15, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x10,
+	0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6d,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x42, 0x10, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x01, 0x48, 0x07, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x08, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa,
+	0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x09, 0x52,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x1c,
+	0x0a, 0x1a, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x75, 0x6d, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x16, 0x0a, 0x14,
+	0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x6d, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x22, 0xae, 0x05, 0x0a, 0x0d, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x57, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x01, 0x52, 0x0e, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x4d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x02, 0x52, 0x0e, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x69,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x1d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x48,
+	0x03, 0x52, 0x1b, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x75, 0x6d, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x39, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f,
+	0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09,
+	0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x04, 0x52, 0x0c, 0x77, 0x69, 0x6e,
+	0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x14,
+	0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75,
+	0x6d, 0x5f, 0x6d, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x42, 0x10, 0xfa, 0x42, 0x0b, 0x12,
+	0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x01, 0x48, 0x05, 0x52, 0x12,
+	0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d,
+	0x4d, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x48, 0x06, 0x52, 0x10, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x69, 0x6e,
+	0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x5f, 0x6d, 0x61, 0x78, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x5f, 0x6d, 0x6d, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x75, 0x6d, 0x22, 0xdd, 0x02, 0x0a, 0x13, 0x43, 0x69, 0x74, 0x79, 0x57, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61,
 	0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x40, 0x0a, 0x06, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x48, 0x6f, 0x75,
+	0x72, 0x6c, 0x79, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x06, 0x68, 0x6f, 0x75,
+	0x72, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x05, 0x64, 0x61, 0x69,
+	0x6c, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x05, 0x75,
+	0x6e, 0x69, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x14, 0x43, 0x69, 0x74, 0x79, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x09,
+	0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x09,
+	0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x2a, 0xde, 0x07, 0x0a, 0x0b, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x45, 0x41,
+	0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x5f,
+	0x53, 0x4b, 0x59, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x4c, 0x59, 0x5f, 0x43, 0x4c, 0x45,
+	0x41, 0x52, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x4c, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55,
+	0x44, 0x59, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x43, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12,
+	0x14, 0x0a, 0x10, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x46, 0x4f, 0x47, 0x10, 0x2d, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x52, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x4f, 0x47, 0x10, 0x30, 0x12, 0x1e, 0x0a, 0x1a, 0x57,
+	0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x5a,
+	0x5a, 0x4c, 0x45, 0x5f, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x33, 0x12, 0x21, 0x0a, 0x1d, 0x57,
+	0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x5a,
+	0x5a, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x35, 0x12, 0x1e,
+	0x0a, 0x1a, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44,
+	0x52, 0x49, 0x5a, 0x5a, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x4e, 0x53, 0x45, 0x10, 0x37, 0x12, 0x27,
+	0x0a, 0x23, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46,
+	0x52, 0x45, 0x45, 0x5a, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x52, 0x49, 0x5a, 0x5a, 0x4c, 0x45, 0x5f,
+	0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x38, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x45, 0x41, 0x54, 0x48,
+	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5a, 0x49, 0x4e, 0x47,
+	0x5f, 0x44, 0x52, 0x49, 0x5a, 0x5a, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x4e, 0x53, 0x45, 0x10, 0x39,
+	0x12, 0x1c, 0x0a, 0x18, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x3d, 0x12, 0x1e,
+	0x0a, 0x1a, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52,
+	0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x3f, 0x12, 0x1b,
+	0x0a, 0x17, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52,
+	0x41, 0x49, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x56, 0x59, 0x10, 0x41, 0x12, 0x24, 0x0a, 0x20, 0x57,
+	0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x45,
+	0x5a, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10,
+	0x42, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5a, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f,
+	0x48, 0x45, 0x41, 0x56, 0x59, 0x10, 0x43, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x45, 0x41, 0x54, 0x48,
+	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x5f, 0x46, 0x41, 0x4c,
+	0x4c, 0x5f, 0x53, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x47, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x45,
+	0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x5f,
+	0x46, 0x41, 0x4c, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x49, 0x12,
+	0x20, 0x0a, 0x1c, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x53, 0x4e, 0x4f, 0x57, 0x5f, 0x46, 0x41, 0x4c, 0x4c, 0x5f, 0x48, 0x45, 0x41, 0x56, 0x59, 0x10,
+	0x4b, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x53, 0x10, 0x4d, 0x12,
+	0x24, 0x0a, 0x20, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x52, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x5f, 0x53, 0x4c, 0x49,
+	0x47, 0x48, 0x54, 0x10, 0x50, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x45,
+	0x52, 0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x51, 0x12, 0x25, 0x0a,
+	0x21, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41,
+	0x49, 0x4e, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x45,
+	0x4e, 0x54, 0x10, 0x52, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x45, 0x52,
+	0x53, 0x5f, 0x53, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x55, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x45,
+	0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x5f,
+	0x53, 0x48, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x5f, 0x48, 0x45, 0x41, 0x56, 0x59, 0x10, 0x56, 0x12,
+	0x24, 0x0a, 0x20, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x54, 0x48, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x53, 0x54, 0x4f, 0x52, 0x4d, 0x5f, 0x53, 0x4c, 0x49,
+	0x47, 0x48, 0x54, 0x10, 0x5f, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x48, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x53, 0x54, 0x4f,
+	0x52, 0x4d, 0x5f, 0x48, 0x41, 0x49, 0x4c, 0x5f, 0x53, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x60,
+	0x12, 0x28, 0x0a, 0x24, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x54, 0x48, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x53, 0x54, 0x4f, 0x52, 0x4d, 0x5f, 0x48, 0x41,
+	0x49, 0x4c, 0x5f, 0x48, 0x45, 0x41, 0x56, 0x59, 0x10, 0x63, 0x42, 0x2d, 0x5a, 0x2b, 0x70, 0x6b,
+	0x67, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x3b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -550,26 +1258,42 @@ func file_current_weather_conditions_proto_rawDescGZIP() []byte {
 }
 
 var file_current_weather_conditions_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_current_weather_conditions_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_current_weather_conditions_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_current_weather_conditions_proto_goTypes = []any{
 	(WeatherCode)(0),              // 0: weather_collector_events.WeatherCode
 	(*Coordinates)(nil),           // 1: weather_collector_events.Coordinates
 	(*City)(nil),                  // 2: weather_collector_events.City
-	(*CityWeatherCondition)(nil),  // 3: weather_collector_events.CityWeatherCondition
-	(*CityWeatherConditions)(nil), // 4: weather_collector_events.CityWeatherConditions
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*MeasurementUnits)(nil),      // 3: weather_collector_events.MeasurementUnits
+	(*CityWeatherCondition)(nil),  // 4: weather_collector_events.CityWeatherCondition
+	(*CityWeatherConditions)(nil), // 5: weather_collector_events.CityWeatherConditions
+	(*HourlyForecast)(nil),        // 6: weather_collector_events.HourlyForecast
+	(*DailyForecast)(nil),         // 7: weather_collector_events.DailyForecast
+	(*CityWeatherForecast)(nil),   // 8: weather_collector_events.CityWeatherForecast
+	(*CityWeatherForecasts)(nil),  // 9: weather_collector_events.CityWeatherForecasts
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
 }
 var file_current_weather_conditions_proto_depIdxs = []int32{
-	1, // 0: weather_collector_events.City.coordinates:type_name -> weather_collector_events.Coordinates
-	2, // 1: weather_collector_events.CityWeatherCondition.city:type_name -> weather_collector_events.City
-	5, // 2: weather_collector_events.CityWeatherCondition.captured_at:type_name -> google.protobuf.Timestamp
-	0, // 3: weather_collector_events.CityWeatherCondition.weather_code:type_name -> weather_collector_events.WeatherCode
-	3, // 4: weather_collector_events.CityWeatherConditions.conditions:type_name -> weather_collector_events.CityWeatherCondition
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1,  // 0: weather_collector_events.City.coordinates:type_name -> weather_collector_events.Coordinates
+	2,  // 1: weather_collector_events.CityWeatherCondition.city:type_name -> weather_collector_events.City
+	10, // 2: weather_collector_events.CityWeatherCondition.captured_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: weather_collector_events.CityWeatherCondition.weather_code:type_name -> weather_collector_events.WeatherCode
+	3,  // 4: weather_collector_events.CityWeatherCondition.units:type_name -> weather_collector_events.MeasurementUnits
+	4,  // 5: weather_collector_events.CityWeatherConditions.conditions:type_name -> weather_collector_events.CityWeatherCondition
+	10, // 6: weather_collector_events.HourlyForecast.time:type_name -> google.protobuf.Timestamp
+	0,  // 7: weather_collector_events.HourlyForecast.weather_code:type_name -> weather_collector_events.WeatherCode
+	10, // 8: weather_collector_events.DailyForecast.date:type_name -> google.protobuf.Timestamp
+	0,  // 9: weather_collector_events.DailyForecast.weather_code:type_name -> weather_collector_events.WeatherCode
+	2,  // 10: weather_collector_events.CityWeatherForecast.city:type_name -> weather_collector_events.City
+	10, // 11: weather_collector_events.CityWeatherForecast.captured_at:type_name -> google.protobuf.Timestamp
+	6,  // 12: weather_collector_events.CityWeatherForecast.hourly:type_name -> weather_collector_events.HourlyForecast
+	7,  // 13: weather_collector_events.CityWeatherForecast.daily:type_name -> weather_collector_events.DailyForecast
+	3,  // 14: weather_collector_events.CityWeatherForecast.units:type_name -> weather_collector_events.MeasurementUnits
+	8,  // 15: weather_collector_events.CityWeatherForecasts.forecasts:type_name -> weather_collector_events.CityWeatherForecast
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_current_weather_conditions_proto_init() }
@@ -603,7 +1327,7 @@ func file_current_weather_conditions_proto_init() {
 			}
 		}
 		file_current_weather_conditions_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CityWeatherCondition); i {
+			switch v := v.(*MeasurementUnits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -615,6 +1339,18 @@ func file_current_weather_conditions_proto_init() {
 			}
 		}
 		file_current_weather_conditions_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CityWeatherCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_current_weather_conditions_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*CityWeatherConditions); i {
 			case 0:
 				return &v.state
@@ -626,14 +1362,65 @@ func file_current_weather_conditions_proto_init() {
 				return nil
 			}
 		}
+		file_current_weather_conditions_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*HourlyForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_current_weather_conditions_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DailyForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_current_weather_conditions_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CityWeatherForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_current_weather_conditions_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*CityWeatherForecasts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_current_weather_conditions_proto_msgTypes[3].OneofWrappers = []any{}
+	file_current_weather_conditions_proto_msgTypes[5].OneofWrappers = []any{}
+	file_current_weather_conditions_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_current_weather_conditions_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
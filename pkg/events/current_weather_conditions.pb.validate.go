@@ -57,9 +57,27 @@ func (m *Coordinates) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Lat
+	if val := m.GetLat(); val < -90 || val > 90 {
+		err := CoordinatesValidationError{
+			field:  "Lat",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Long
+	if val := m.GetLong(); val < -180 || val > 180 {
+		err := CoordinatesValidationError{
+			field:  "Long",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return CoordinatesMultiError(errors)
@@ -159,7 +177,16 @@ func (m *City) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CityValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if all {
 		switch v := interface{}(m.GetCoordinates()).(type) {
@@ -190,6 +217,17 @@ func (m *City) validate(all bool) error {
 		}
 	}
 
+	if m.GetId() <= 0 {
+		err := CityValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CityMultiError(errors)
 	}
@@ -267,6 +305,114 @@ var _ interface {
 	ErrorName() string
 } = CityValidationError{}
 
+// Validate checks the field values on MeasurementUnits with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MeasurementUnits) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MeasurementUnits with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MeasurementUnitsMultiError, or nil if none found.
+func (m *MeasurementUnits) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MeasurementUnits) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Temperature
+
+	// no validation rules for WindSpeed
+
+	// no validation rules for Precipitation
+
+	// no validation rules for Visibility
+
+	if len(errors) > 0 {
+		return MeasurementUnitsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MeasurementUnitsMultiError is an error wrapping multiple validation errors
+// returned by MeasurementUnits.ValidateAll() if the designated constraints
+// aren't met.
+type MeasurementUnitsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MeasurementUnitsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MeasurementUnitsMultiError) AllErrors() []error { return m }
+
+// MeasurementUnitsValidationError is the validation error returned by
+// MeasurementUnits.Validate if the designated constraints aren't met.
+type MeasurementUnitsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MeasurementUnitsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MeasurementUnitsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MeasurementUnitsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MeasurementUnitsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MeasurementUnitsValidationError) ErrorName() string { return "MeasurementUnitsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MeasurementUnitsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMeasurementUnits.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MeasurementUnitsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MeasurementUnitsValidationError{}
+
 // Validate checks the field values on CityWeatherCondition with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -289,6 +435,17 @@ func (m *CityWeatherCondition) validate(all bool) error {
 
 	var errors []error
 
+	if m.GetCity() == nil {
+		err := CityWeatherConditionValidationError{
+			field:  "City",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetCity()).(type) {
 		case interface{ ValidateAll() error }:
@@ -318,12 +475,25 @@ func (m *CityWeatherCondition) validate(all bool) error {
 		}
 	}
 
+	if m.GetCapturedAt() == nil {
+		err := CityWeatherConditionValidationError{
+			field:  "CapturedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Provider
+
 	if all {
-		switch v := interface{}(m.GetCapturedAt()).(type) {
+		switch v := interface{}(m.GetUnits()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
 				errors = append(errors, CityWeatherConditionValidationError{
-					field:  "CapturedAt",
+					field:  "Units",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
@@ -331,35 +501,149 @@ func (m *CityWeatherCondition) validate(all bool) error {
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
 				errors = append(errors, CityWeatherConditionValidationError{
-					field:  "CapturedAt",
+					field:  "Units",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCapturedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUnits()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return CityWeatherConditionValidationError{
-				field:  "CapturedAt",
+				field:  "Units",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Temperature
+	if m.Temperature != nil {
+		// no validation rules for Temperature
+	}
 
-	// no validation rules for RelativeHumidityPercent
+	if m.RelativeHumidityPercent != nil {
 
-	// no validation rules for WindSpeed
+		if m.GetRelativeHumidityPercent() > 100 {
+			err := CityWeatherConditionValidationError{
+				field:  "RelativeHumidityPercent",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.WindSpeed != nil {
+
+		if m.GetWindSpeed() < 0 {
+			err := CityWeatherConditionValidationError{
+				field:  "WindSpeed",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.WeatherCode != nil {
+
+		if _, ok := WeatherCode_name[int32(m.GetWeatherCode())]; !ok {
+			err := CityWeatherConditionValidationError{
+				field:  "WeatherCode",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.CloudCoverPercent != nil {
+
+		if m.GetCloudCoverPercent() > 100 {
+			err := CityWeatherConditionValidationError{
+				field:  "CloudCoverPercent",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for WeatherCode
+	}
+
+	if m.PrecipitationMillimeters != nil {
+		// no validation rules for PrecipitationMillimeters
+	}
+
+	if m.VisibilityMillimeters != nil {
+
+		if m.GetVisibilityMillimeters() < 0 {
+			err := CityWeatherConditionValidationError{
+				field:  "VisibilityMillimeters",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PrecipitationMm != nil {
+
+		if m.GetPrecipitationMm() < 0 {
+			err := CityWeatherConditionValidationError{
+				field:  "PrecipitationMm",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Precipitation != nil {
+
+		if m.GetPrecipitation() < 0 {
+			err := CityWeatherConditionValidationError{
+				field:  "Precipitation",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
-	// no validation rules for CloudCoverPercent
+	if m.Visibility != nil {
 
-	// no validation rules for PrecipitationMillimeters
+		if m.GetVisibility() < 0 {
+			err := CityWeatherConditionValidationError{
+				field:  "Visibility",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for VisibilityMillimeters
+	}
 
 	if len(errors) > 0 {
 		return CityWeatherConditionMultiError(errors)
@@ -576,3 +860,811 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CityWeatherConditionsValidationError{}
+
+// Validate checks the field values on HourlyForecast with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HourlyForecast) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HourlyForecast with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HourlyForecastMultiError,
+// or nil if none found.
+func (m *HourlyForecast) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HourlyForecast) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTime() == nil {
+		err := HourlyForecastValidationError{
+			field:  "Time",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Temperature != nil {
+		// no validation rules for Temperature
+	}
+
+	if m.RelativeHumidityPercent != nil {
+
+		if m.GetRelativeHumidityPercent() > 100 {
+			err := HourlyForecastValidationError{
+				field:  "RelativeHumidityPercent",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.WindSpeed != nil {
+
+		if m.GetWindSpeed() < 0 {
+			err := HourlyForecastValidationError{
+				field:  "WindSpeed",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.WeatherCode != nil {
+
+		if _, ok := WeatherCode_name[int32(m.GetWeatherCode())]; !ok {
+			err := HourlyForecastValidationError{
+				field:  "WeatherCode",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.CloudCoverPercent != nil {
+
+		if m.GetCloudCoverPercent() > 100 {
+			err := HourlyForecastValidationError{
+				field:  "CloudCoverPercent",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PrecipitationMillimeters != nil {
+		// no validation rules for PrecipitationMillimeters
+	}
+
+	if m.VisibilityMillimeters != nil {
+
+		if m.GetVisibilityMillimeters() < 0 {
+			err := HourlyForecastValidationError{
+				field:  "VisibilityMillimeters",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PrecipitationMm != nil {
+
+		if m.GetPrecipitationMm() < 0 {
+			err := HourlyForecastValidationError{
+				field:  "PrecipitationMm",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Precipitation != nil {
+
+		if m.GetPrecipitation() < 0 {
+			err := HourlyForecastValidationError{
+				field:  "Precipitation",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Visibility != nil {
+
+		if m.GetVisibility() < 0 {
+			err := HourlyForecastValidationError{
+				field:  "Visibility",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HourlyForecastMultiError(errors)
+	}
+
+	return nil
+}
+
+// HourlyForecastMultiError is an error wrapping multiple validation errors
+// returned by HourlyForecast.ValidateAll() if the designated constraints
+// aren't met.
+type HourlyForecastMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HourlyForecastMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HourlyForecastMultiError) AllErrors() []error { return m }
+
+// HourlyForecastValidationError is the validation error returned by
+// HourlyForecast.Validate if the designated constraints aren't met.
+type HourlyForecastValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HourlyForecastValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HourlyForecastValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HourlyForecastValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HourlyForecastValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HourlyForecastValidationError) ErrorName() string { return "HourlyForecastValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HourlyForecastValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHourlyForecast.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HourlyForecastValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HourlyForecastValidationError{}
+
+// Validate checks the field values on DailyForecast with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DailyForecast) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyForecast with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DailyForecastMultiError, or
+// nil if none found.
+func (m *DailyForecast) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyForecast) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDate() == nil {
+		err := DailyForecastValidationError{
+			field:  "Date",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.WeatherCode != nil {
+
+		if _, ok := WeatherCode_name[int32(m.GetWeatherCode())]; !ok {
+			err := DailyForecastValidationError{
+				field:  "WeatherCode",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.TemperatureMax != nil {
+		// no validation rules for TemperatureMax
+	}
+
+	if m.TemperatureMin != nil {
+		// no validation rules for TemperatureMin
+	}
+
+	if m.PrecipitationSumMillimeters != nil {
+		// no validation rules for PrecipitationSumMillimeters
+	}
+
+	if m.WindSpeedMax != nil {
+
+		if m.GetWindSpeedMax() < 0 {
+			err := DailyForecastValidationError{
+				field:  "WindSpeedMax",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PrecipitationSumMm != nil {
+
+		if m.GetPrecipitationSumMm() < 0 {
+			err := DailyForecastValidationError{
+				field:  "PrecipitationSumMm",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PrecipitationSum != nil {
+
+		if m.GetPrecipitationSum() < 0 {
+			err := DailyForecastValidationError{
+				field:  "PrecipitationSum",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DailyForecastMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyForecastMultiError is an error wrapping multiple validation errors
+// returned by DailyForecast.ValidateAll() if the designated constraints
+// aren't met.
+type DailyForecastMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyForecastMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyForecastMultiError) AllErrors() []error { return m }
+
+// DailyForecastValidationError is the validation error returned by
+// DailyForecast.Validate if the designated constraints aren't met.
+type DailyForecastValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyForecastValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyForecastValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyForecastValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyForecastValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyForecastValidationError) ErrorName() string { return "DailyForecastValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DailyForecastValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyForecast.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyForecastValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyForecastValidationError{}
+
+// Validate checks the field values on CityWeatherForecast with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CityWeatherForecast) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityWeatherForecast with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CityWeatherForecastMultiError, or nil if none found.
+func (m *CityWeatherForecast) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityWeatherForecast) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCity() == nil {
+		err := CityWeatherForecastValidationError{
+			field:  "City",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityWeatherForecastValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityWeatherForecastValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityWeatherForecastValidationError{
+				field:  "City",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetCapturedAt() == nil {
+		err := CityWeatherForecastValidationError{
+			field:  "CapturedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetHourly() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CityWeatherForecastValidationError{
+						field:  fmt.Sprintf("Hourly[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CityWeatherForecastValidationError{
+						field:  fmt.Sprintf("Hourly[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CityWeatherForecastValidationError{
+					field:  fmt.Sprintf("Hourly[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDaily() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CityWeatherForecastValidationError{
+						field:  fmt.Sprintf("Daily[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CityWeatherForecastValidationError{
+						field:  fmt.Sprintf("Daily[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CityWeatherForecastValidationError{
+					field:  fmt.Sprintf("Daily[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUnits()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityWeatherForecastValidationError{
+					field:  "Units",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityWeatherForecastValidationError{
+					field:  "Units",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnits()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityWeatherForecastValidationError{
+				field:  "Units",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CityWeatherForecastMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityWeatherForecastMultiError is an error wrapping multiple validation
+// errors returned by CityWeatherForecast.ValidateAll() if the designated
+// constraints aren't met.
+type CityWeatherForecastMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityWeatherForecastMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityWeatherForecastMultiError) AllErrors() []error { return m }
+
+// CityWeatherForecastValidationError is the validation error returned by
+// CityWeatherForecast.Validate if the designated constraints aren't met.
+type CityWeatherForecastValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityWeatherForecastValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityWeatherForecastValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityWeatherForecastValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityWeatherForecastValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityWeatherForecastValidationError) ErrorName() string {
+	return "CityWeatherForecastValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CityWeatherForecastValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityWeatherForecast.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityWeatherForecastValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityWeatherForecastValidationError{}
+
+// Validate checks the field values on CityWeatherForecasts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CityWeatherForecasts) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityWeatherForecasts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CityWeatherForecastsMultiError, or nil if none found.
+func (m *CityWeatherForecasts) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityWeatherForecasts) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetForecasts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CityWeatherForecastsValidationError{
+						field:  fmt.Sprintf("Forecasts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CityWeatherForecastsValidationError{
+						field:  fmt.Sprintf("Forecasts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CityWeatherForecastsValidationError{
+					field:  fmt.Sprintf("Forecasts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CityWeatherForecastsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityWeatherForecastsMultiError is an error wrapping multiple validation
+// errors returned by CityWeatherForecasts.ValidateAll() if the designated
+// constraints aren't met.
+type CityWeatherForecastsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityWeatherForecastsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityWeatherForecastsMultiError) AllErrors() []error { return m }
+
+// CityWeatherForecastsValidationError is the validation error returned by
+// CityWeatherForecasts.Validate if the designated constraints aren't met.
+type CityWeatherForecastsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityWeatherForecastsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityWeatherForecastsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityWeatherForecastsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityWeatherForecastsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityWeatherForecastsValidationError) ErrorName() string {
+	return "CityWeatherForecastsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CityWeatherForecastsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityWeatherForecasts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityWeatherForecastsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityWeatherForecastsValidationError{}
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: weather_alerts.proto
+
+package weather_collector_events
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on WeatherAlert with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WeatherAlert) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WeatherAlert with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WeatherAlertMultiError, or
+// nil if none found.
+func (m *WeatherAlert) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WeatherAlert) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRule()) < 1 {
+		err := WeatherAlertValidationError{
+			field:  "Rule",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if _, ok := _WeatherAlert_Severity_NotInLookup[m.GetSeverity()]; ok {
+		err := WeatherAlertValidationError{
+			field:  "Severity",
+			reason: "value must not be in list [ALERT_SEVERITY_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AlertSeverity_name[int32(m.GetSeverity())]; !ok {
+		err := WeatherAlertValidationError{
+			field:  "Severity",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _WeatherAlert_State_NotInLookup[m.GetState()]; ok {
+		err := WeatherAlertValidationError{
+			field:  "State",
+			reason: "value must not be in list [ALERT_STATE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AlertState_name[int32(m.GetState())]; !ok {
+		err := WeatherAlertValidationError{
+			field:  "State",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCity() == nil {
+		err := WeatherAlertValidationError{
+			field:  "City",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WeatherAlertValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WeatherAlertValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WeatherAlertValidationError{
+				field:  "City",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetChangedAt() == nil {
+		err := WeatherAlertValidationError{
+			field:  "ChangedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCondition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WeatherAlertValidationError{
+					field:  "Condition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WeatherAlertValidationError{
+					field:  "Condition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCondition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WeatherAlertValidationError{
+				field:  "Condition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WeatherAlertMultiError(errors)
+	}
+
+	return nil
+}
+
+// WeatherAlertMultiError is an error wrapping multiple validation errors
+// returned by WeatherAlert.ValidateAll() if the designated constraints aren't met.
+type WeatherAlertMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WeatherAlertMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WeatherAlertMultiError) AllErrors() []error { return m }
+
+// WeatherAlertValidationError is the validation error returned by
+// WeatherAlert.Validate if the designated constraints aren't met.
+type WeatherAlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WeatherAlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WeatherAlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WeatherAlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WeatherAlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WeatherAlertValidationError) ErrorName() string { return "WeatherAlertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WeatherAlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWeatherAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WeatherAlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WeatherAlertValidationError{}
+
+var _WeatherAlert_Severity_NotInLookup = map[AlertSeverity]struct{}{
+	0: {},
+}
+
+var _WeatherAlert_State_NotInLookup = map[AlertState]struct{}{
+	0: {},
+}
@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: weather_collector_service.proto
+
+package weather_collector_events
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WeatherCollectorService_GetCurrent_FullMethodName  = "/weather_collector_events.WeatherCollectorService/GetCurrent"
+	WeatherCollectorService_ListCurrent_FullMethodName = "/weather_collector_events.WeatherCollectorService/ListCurrent"
+	WeatherCollectorService_Subscribe_FullMethodName   = "/weather_collector_events.WeatherCollectorService/Subscribe"
+)
+
+// WeatherCollectorServiceClient is the client API for WeatherCollectorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WeatherCollectorService serves the conditions collected by the service.
+// units accepts "metric", "imperial" or a JSON object naming the unit of
+// every quantity, metric when empty.
+type WeatherCollectorServiceClient interface {
+	GetCurrent(ctx context.Context, in *GetCurrentRequest, opts ...grpc.CallOption) (*GetCurrentResponse, error)
+	ListCurrent(ctx context.Context, in *ListCurrentRequest, opts ...grpc.CallOption) (*ListCurrentResponse, error)
+	// Subscribe streams the latest stored reading of the cities, then every
+	// new one as it is collected. New readings are only streamed by the
+	// replica that collected them.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CityWeatherCondition], error)
+}
+
+type weatherCollectorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWeatherCollectorServiceClient(cc grpc.ClientConnInterface) WeatherCollectorServiceClient {
+	return &weatherCollectorServiceClient{cc}
+}
+
+func (c *weatherCollectorServiceClient) GetCurrent(ctx context.Context, in *GetCurrentRequest, opts ...grpc.CallOption) (*GetCurrentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrentResponse)
+	err := c.cc.Invoke(ctx, WeatherCollectorService_GetCurrent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherCollectorServiceClient) ListCurrent(ctx context.Context, in *ListCurrentRequest, opts ...grpc.CallOption) (*ListCurrentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCurrentResponse)
+	err := c.cc.Invoke(ctx, WeatherCollectorService_ListCurrent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherCollectorServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CityWeatherCondition], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WeatherCollectorService_ServiceDesc.Streams[0], WeatherCollectorService_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, CityWeatherCondition]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WeatherCollectorService_SubscribeClient = grpc.ServerStreamingClient[CityWeatherCondition]
+
+// WeatherCollectorServiceServer is the server API for WeatherCollectorService service.
+// All implementations must embed UnimplementedWeatherCollectorServiceServer
+// for forward compatibility.
+//
+// WeatherCollectorService serves the conditions collected by the service.
+// units accepts "metric", "imperial" or a JSON object naming the unit of
+// every quantity, metric when empty.
+type WeatherCollectorServiceServer interface {
+	GetCurrent(context.Context, *GetCurrentRequest) (*GetCurrentResponse, error)
+	ListCurrent(context.Context, *ListCurrentRequest) (*ListCurrentResponse, error)
+	// Subscribe streams the latest stored reading of the cities, then every
+	// new one as it is collected. New readings are only streamed by the
+	// replica that collected them.
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[CityWeatherCondition]) error
+	mustEmbedUnimplementedWeatherCollectorServiceServer()
+}
+
+// UnimplementedWeatherCollectorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWeatherCollectorServiceServer struct{}
+
+func (UnimplementedWeatherCollectorServiceServer) GetCurrent(context.Context, *GetCurrentRequest) (*GetCurrentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrent not implemented")
+}
+func (UnimplementedWeatherCollectorServiceServer) ListCurrent(context.Context, *ListCurrentRequest) (*ListCurrentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrent not implemented")
+}
+func (UnimplementedWeatherCollectorServiceServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[CityWeatherCondition]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedWeatherCollectorServiceServer) mustEmbedUnimplementedWeatherCollectorServiceServer() {
+}
+func (UnimplementedWeatherCollectorServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWeatherCollectorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WeatherCollectorServiceServer will
+// result in compilation errors.
+type UnsafeWeatherCollectorServiceServer interface {
+	mustEmbedUnimplementedWeatherCollectorServiceServer()
+}
+
+func RegisterWeatherCollectorServiceServer(s grpc.ServiceRegistrar, srv WeatherCollectorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWeatherCollectorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WeatherCollectorService_ServiceDesc, srv)
+}
+
+func _WeatherCollectorService_GetCurrent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherCollectorServiceServer).GetCurrent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherCollectorService_GetCurrent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherCollectorServiceServer).GetCurrent(ctx, req.(*GetCurrentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherCollectorService_ListCurrent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherCollectorServiceServer).ListCurrent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherCollectorService_ListCurrent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherCollectorServiceServer).ListCurrent(ctx, req.(*ListCurrentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherCollectorService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeatherCollectorServiceServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, CityWeatherCondition]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WeatherCollectorService_SubscribeServer = grpc.ServerStreamingServer[CityWeatherCondition]
+
+// WeatherCollectorService_ServiceDesc is the grpc.ServiceDesc for WeatherCollectorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WeatherCollectorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "weather_collector_events.WeatherCollectorService",
+	HandlerType: (*WeatherCollectorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCurrent",
+			Handler:    _WeatherCollectorService_GetCurrent_Handler,
+		},
+		{
+			MethodName: "ListCurrent",
+			Handler:    _WeatherCollectorService_ListCurrent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _WeatherCollectorService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "weather_collector_service.proto",
+}